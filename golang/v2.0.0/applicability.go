@@ -0,0 +1,224 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Specificity is the granularity of the geographic scope of a CarbonFootprint
+type Specificity int
+
+const (
+	// no geography declared
+	GlobalSpecificity Specificity = iota
+
+	// geographyRegionOrSubregion is a UN geographic region
+	RegionSpecificity
+
+	// geographyRegionOrSubregion is a UN geographic subregion
+	SubregionSpecificity
+
+	// geographyCountry
+	CountrySpecificity
+
+	// geographyCountrySubdivision
+	SubdivisionSpecificity
+)
+
+func (s Specificity) String() string {
+	switch s {
+	case GlobalSpecificity:
+		return "global"
+	case RegionSpecificity:
+		return "region"
+	case SubregionSpecificity:
+		return "subregion"
+	case CountrySpecificity:
+		return "country"
+	case SubdivisionSpecificity:
+		return "subdivision"
+	}
+	return fmt.Sprintf("Specificity(%d)", int(s))
+}
+
+// The maximum validity period of a PCF after the end of its reference period,
+// used when no validity period is declared
+const maximumValidityYears = 3
+
+// ApplicabilityQuery describes where and when a product is delivered
+type ApplicabilityQuery struct {
+
+	// The country of the delivery location
+	//
+	// Mandatory
+	Country ISO3166CC
+
+	// If known, the subdivision of the delivery location
+	//
+	// Optional
+	Subdivision ISO3166Subdivision
+
+	// The delivery date
+	//
+	// Mandatory
+	Date time.Time
+}
+
+// Applicability is the evaluation of a candidate ProductFootprint for an ApplicabilityQuery
+type Applicability struct {
+
+	// The candidate
+	Footprint ProductFootprint
+
+	// Whether the footprint can be used for the query
+	Applicable bool
+
+	// The granularity of the geographic scope of the footprint
+	Specificity Specificity
+
+	// Whether the footprint declares a validity period covering the date,
+	// rather than being considered valid up to 3 years after its reference period
+	ExplicitValidity bool
+
+	// Why the footprint was chosen, ranked or rejected
+	Reasons []string
+}
+
+// geographicFit evaluates whether the geographic scope of the CarbonFootprint covers the location
+func (q ApplicabilityQuery) geographicFit(c CarbonFootprint, a *Applicability) bool {
+	switch {
+	case c.GeographyCountrySubdivision != "":
+		a.Specificity = SubdivisionSpecificity
+		if c.GeographyCountrySubdivision == q.Subdivision {
+			a.Reasons = append(a.Reasons, fmt.Sprintf("subdivision %s matches the location", c.GeographyCountrySubdivision))
+			return true
+		}
+		if q.Subdivision == "" {
+			a.Reasons = append(a.Reasons, fmt.Sprintf("rejected: scoped to subdivision %s while the location subdivision is unknown", c.GeographyCountrySubdivision))
+		} else {
+			a.Reasons = append(a.Reasons, fmt.Sprintf("rejected: subdivision %s does not cover %s", c.GeographyCountrySubdivision, q.Subdivision))
+		}
+		return false
+
+	case c.GeographyCountry != "":
+		a.Specificity = CountrySpecificity
+		if c.GeographyCountry == q.Country {
+			a.Reasons = append(a.Reasons, fmt.Sprintf("country %s matches the location", c.GeographyCountry))
+			return true
+		}
+		a.Reasons = append(a.Reasons, fmt.Sprintf("rejected: country %s does not cover %s", c.GeographyCountry, q.Country))
+		return false
+
+	case c.GeographyRegionOrSubregion != "":
+		a.Specificity = SubregionSpecificity
+		if c.GeographyRegionOrSubregion.IsRegion() {
+			a.Specificity = RegionSpecificity
+		}
+		if InRegion(q.Country.String(), c.GeographyRegionOrSubregion) {
+			a.Reasons = append(a.Reasons, fmt.Sprintf("%s %s covers %s", a.Specificity, c.GeographyRegionOrSubregion, q.Country))
+			return true
+		}
+		a.Reasons = append(a.Reasons, fmt.Sprintf("rejected: %s %s does not cover %s", a.Specificity, c.GeographyRegionOrSubregion, q.Country))
+		return false
+	}
+
+	a.Specificity = GlobalSpecificity
+	a.Reasons = append(a.Reasons, "global footprint covers every location")
+	return true
+}
+
+// temporalFit evaluates whether the ProductFootprint is valid at the date
+func (q ApplicabilityQuery) temporalFit(f ProductFootprint, a *Applicability) bool {
+	if !f.ValidityPeriodStart.IsZero() || !f.ValidityPeriodEnd.IsZero() {
+		a.ExplicitValidity = true
+		if q.Date.Before(f.ValidityPeriodStart) || (!f.ValidityPeriodEnd.IsZero() && !q.Date.Before(f.ValidityPeriodEnd)) {
+			a.Reasons = append(a.Reasons, fmt.Sprintf("rejected: %s is outside the validity period %s - %s",
+				q.Date.Format(time.DateOnly), f.ValidityPeriodStart.Format(time.DateOnly), f.ValidityPeriodEnd.Format(time.DateOnly)))
+			return false
+		}
+		a.Reasons = append(a.Reasons, "date within the validity period")
+		return true
+	}
+
+	start := f.Pcf.ReferencePeriodStart
+	end := f.Pcf.ReferencePeriodEnd.AddDate(maximumValidityYears, 0, 0)
+	if q.Date.Before(start) || !q.Date.Before(end) {
+		a.Reasons = append(a.Reasons, fmt.Sprintf("rejected: %s is outside the reference period %s plus %d years",
+			q.Date.Format(time.DateOnly), start.Format(time.DateOnly), maximumValidityYears))
+		return false
+	}
+	a.Reasons = append(a.Reasons, fmt.Sprintf("date within %d years of the reference period", maximumValidityYears))
+	return true
+}
+
+// Evaluate evaluates whether the ProductFootprint can be used for the query
+func (q ApplicabilityQuery) Evaluate(footprint ProductFootprint) Applicability {
+	a := Applicability{Footprint: footprint}
+
+	geographic := q.geographicFit(footprint.Pcf, &a)
+	temporal := q.temporalFit(footprint, &a)
+
+	active := footprint.Status != Deprecated
+	if !active {
+		a.Reasons = append(a.Reasons, "rejected: status Deprecated")
+	}
+
+	a.Applicable = geographic && temporal && active
+	return a
+}
+
+// less ranks the applicable footprints first, then by specificity, explicit validity,
+// most recent reference period and version
+func (a Applicability) less(b Applicability) bool {
+	if a.Applicable != b.Applicable {
+		return a.Applicable
+	}
+	if a.Specificity != b.Specificity {
+		return a.Specificity > b.Specificity
+	}
+	if a.ExplicitValidity != b.ExplicitValidity {
+		return a.ExplicitValidity
+	}
+	if end, other := a.Footprint.Pcf.ReferencePeriodEnd, b.Footprint.Pcf.ReferencePeriodEnd; !end.Equal(other) {
+		return end.After(other)
+	}
+	return a.Footprint.Version > b.Footprint.Version
+}
+
+// Resolve evaluates the candidate footprints for the query and ranks them,
+// the best applicable candidate first. Subdivision footprints rank before country,
+// subregion, region and global ones, then footprints with a declared validity period,
+// then the most recent reference period.
+func (q ApplicabilityQuery) Resolve(candidates []ProductFootprint) []Applicability {
+	result := make([]Applicability, len(candidates))
+	for i, candidate := range candidates {
+		result[i] = q.Evaluate(candidate)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].less(result[j])
+	})
+
+	for i := range result {
+		if !result[i].Applicable {
+			break
+		}
+		if i == 0 {
+			result[i].Reasons = append(result[i].Reasons, fmt.Sprintf("chosen: most specific (%s) applicable footprint", result[i].Specificity))
+		} else {
+			result[i].Reasons = append(result[i].Reasons, fmt.Sprintf("ranked %d: less specific or older than the chosen footprint", i+1))
+		}
+	}
+
+	return result
+}
+
+// Best returns the best applicable footprint for the query
+func (q ApplicabilityQuery) Best(candidates []ProductFootprint) (Applicability, bool) {
+	ranked := q.Resolve(candidates)
+	if len(ranked) == 0 || !ranked[0].Applicable {
+		return Applicability{}, false
+	}
+	return ranked[0], true
+}
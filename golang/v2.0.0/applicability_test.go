@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func applicabilityCandidate(version int32, pcf CarbonFootprint) ProductFootprint {
+	pcf.ReferencePeriodStart = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	pcf.ReferencePeriodEnd = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	return ProductFootprint{Version: version, Status: Active, Pcf: pcf}
+}
+
+func TestResolveApplicability(t *testing.T) {
+
+	global := applicabilityCandidate(1, CarbonFootprint{})
+	europe := applicabilityCandidate(2, CarbonFootprint{GeographyRegionOrSubregion: Europe})
+	western := applicabilityCandidate(3, CarbonFootprint{GeographyRegionOrSubregion: WesternEurope})
+	france := applicabilityCandidate(4, CarbonFootprint{GeographyCountry: "FR"})
+	yonne := applicabilityCandidate(5, CarbonFootprint{GeographyCountrySubdivision: "FR-89"})
+	germany := applicabilityCandidate(6, CarbonFootprint{GeographyCountry: "DE"})
+
+	query := ApplicabilityQuery{Country: "FR", Date: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}
+	ranked := query.Resolve([]ProductFootprint{global, germany, europe, yonne, western, france})
+
+	versions := make([]int32, len(ranked))
+	for i, candidate := range ranked {
+		versions[i] = candidate.Footprint.Version
+	}
+	assert.Equal(t, []int32{4, 3, 2, 1, 5, 6}, versions)
+	assert.True(t, ranked[3].Applicable)
+	assert.False(t, ranked[4].Applicable)
+	assert.Contains(t, ranked[0].Reasons[len(ranked[0].Reasons)-1], "chosen")
+
+	query.Subdivision = "FR-89"
+	best, ok := query.Best([]ProductFootprint{global, france, yonne})
+	assert.True(t, ok)
+	assert.Equal(t, SubdivisionSpecificity, best.Specificity)
+
+}
+
+func TestResolveApplicabilityTemporal(t *testing.T) {
+
+	outdated := applicabilityCandidate(1, CarbonFootprint{GeographyCountry: "FR"})
+	deprecated := applicabilityCandidate(2, CarbonFootprint{GeographyCountry: "FR"})
+	deprecated.Status = Deprecated
+	valid := applicabilityCandidate(3, CarbonFootprint{GeographyCountry: "FR"})
+	valid.ValidityPeriodStart = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	valid.ValidityPeriodEnd = time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := ApplicabilityQuery{Country: "FR", Date: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)}
+
+	assert.False(t, query.Evaluate(outdated).Applicable)
+	assert.False(t, query.Evaluate(deprecated).Applicable)
+
+	best, ok := query.Best([]ProductFootprint{outdated, deprecated, valid})
+	assert.True(t, ok)
+	assert.Equal(t, int32(3), best.Footprint.Version)
+	assert.True(t, best.ExplicitValidity)
+
+	_, ok = query.Best([]ProductFootprint{outdated, deprecated})
+	assert.False(t, ok)
+
+}
@@ -1,7 +1,62 @@
 package schema
 
-import "time"
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+	"time"
+)
 
+// Error when a property of the Assurance required when the CarbonFootprint is assured is undefined
+var ErrAssuranceRequired = errors.New("required when assurance is true")
+
+// Error when the statementOrSignature of the Assurance is not valid
+var ErrAttachmentInvalid = errors.New("invalid statementOrSignature")
+
+// Error when a referenced statement or signature does not match its digest
+var ErrAttachmentDigest = errors.New("statementOrSignature digest mismatch")
+
+// AssuranceStandard is the standard against which a PCF was assured, e.g. ISAE 3000
+type AssuranceStandard string
+
+const (
+	// International Standard on Assurance Engagements 3000
+	ISAE3000 AssuranceStandard = "ISAE 3000"
+
+	// International Standard on Assurance Engagements 3410, assurance engagements on greenhouse gas statements
+	ISAE3410 AssuranceStandard = "ISAE 3410"
+
+	// ISO 14064-3, specification for the verification and validation of greenhouse gas statements
+	ISO14064Part3 AssuranceStandard = "ISO 14064-3"
+
+	// AccountAbility AA1000 Assurance Standard
+	AA1000AS AssuranceStandard = "AA1000AS"
+)
+
+var assuranceStandards = []AssuranceStandard{ISAE3000, ISAE3410, ISO14064Part3, AA1000AS}
+
+// IsKnown reports whether the value is one of the well-known assurance standards,
+// other standards being allowed
+func (u AssuranceStandard) IsKnown() bool {
+	for _, standard := range assuranceStandards {
+		if u == standard {
+			return true
+		}
+	}
+	return false
+}
+
+func (u AssuranceStandard) String() string {
+	return string(u)
+}
+
+// Assurance is the assurance information of a CarbonFootprint
 type Assurance struct {
 
 	// A boolean flag indicating whether the CarbonFootprint has been assured
@@ -44,10 +99,169 @@ type Assurance struct {
 	// Optional
 	CompletedAt time.Time `json:"completedAt,omitempty"`
 
-	// Name of the standard against which the PCF was assured.
-	StandardName Standard `json:"standardName,omitempty"`
+	// Name of the standard against which the PCF was assured, e.g. ISAE 3000.
+	// It is also read from the property standard.
+	//
+	// Optional
+	StandardName AssuranceStandard `json:"standardName,omitempty"`
+
+	// The assurance statement, or the signature of the assurance provider,
+	// embedded or referenced by URL with its digest.
+	//
+	// Optional
+	StatementOrSignature *Attachment `json:"statementOrSignature,omitempty"`
 
 	// Any additional comments that will clarify the interpretation of the assurance.
 	// The value of this property MAY be the empty string.
 	Comments string `json:"comments,omitempty"`
 }
+
+// Attachment is an assurance statement or signature, either embedded in its value
+// or referenced by its URL and digest
+type Attachment struct {
+
+	// statement or signature
+	//
+	// Mandatory
+	Type AttachmentType `json:"type"`
+
+	// The embedded statement or signature, e.g. a base64 encoded document or a JWS.
+	// Exclusive with url.
+	//
+	// Optional
+	Value string `json:"value,omitempty"`
+
+	// The URL of the statement or signature. Exclusive with value.
+	//
+	// Optional
+	URL string `json:"url,omitempty"`
+
+	// The digest of the referenced statement or signature, as algorithm:hex,
+	// e.g. sha256:9f86d0818...; the algorithm is sha256 or sha512.
+	// Mandatory with url.
+	//
+	// Optional
+	Digest string `json:"digest,omitempty"`
+
+	// The media type of the statement or signature, e.g. application/pdf
+	//
+	// Optional
+	MediaType string `json:"mediaType,omitempty"`
+}
+
+// digestHash returns the hash of the algorithm of the digest and the expected sum
+func (a Attachment) digestHash() (hash.Hash, []byte, error) {
+	algorithm, value, _ := strings.Cut(a.Digest, ":")
+	sum, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: digest: %w", ErrAttachmentInvalid, err)
+	}
+
+	var h hash.Hash
+	switch strings.ToLower(algorithm) {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return nil, nil, fmt.Errorf("%w: digest: unsupported algorithm %q", ErrAttachmentInvalid, algorithm)
+	}
+	if len(sum) != h.Size() {
+		return nil, nil, fmt.Errorf("%w: digest: %d bytes instead of %d", ErrAttachmentInvalid, len(sum), h.Size())
+	}
+	return h, sum, nil
+}
+
+// Validate checks the attachment is either embedded or referenced with a valid digest
+func (a Attachment) Validate() error {
+	var errs []error
+	if !a.Type.IsValid() {
+		_, err := ParseAttachmentType(string(a.Type))
+		errs = append(errs, fmt.Errorf("%w: type: %w", ErrAttachmentInvalid, err))
+	}
+
+	switch {
+	case a.Value == "" && a.URL == "":
+		errs = append(errs, fmt.Errorf("%w: one of value and url is required", ErrAttachmentInvalid))
+	case a.Value != "" && a.URL != "":
+		errs = append(errs, fmt.Errorf("%w: value and url are exclusive", ErrAttachmentInvalid))
+	case a.URL != "":
+		if a.Digest == "" {
+			errs = append(errs, fmt.Errorf("%w: digest: required with url", ErrAttachmentInvalid))
+		} else if _, _, err := a.digestHash(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// VerifyDigest checks the content retrieved from the URL of the attachment matches its digest
+func (a Attachment) VerifyDigest(content []byte) error {
+	h, sum, err := a.digestHash()
+	if err != nil {
+		return err
+	}
+
+	h.Write(content)
+	if subtle.ConstantTimeCompare(h.Sum(nil), sum) != 1 {
+		return ErrAttachmentDigest
+	}
+	return nil
+}
+
+// NewAttachmentReference returns the attachment referencing the content at the URL by its SHA-256 digest
+func NewAttachmentReference(attachmentType AttachmentType, url string, content []byte) Attachment {
+	sum := sha256.Sum256(content)
+	return Attachment{Type: attachmentType, URL: url, Digest: "sha256:" + hex.EncodeToString(sum[:])}
+}
+
+// assurance has the fields of Assurance without its methods
+type assurance Assurance
+
+// UnmarshalJSON reads the standard against which the PCF was assured
+// from the property standardName or, if undefined, standard
+func (a *Assurance) UnmarshalJSON(data []byte) error {
+	var value struct {
+		assurance
+		Standard AssuranceStandard `json:"standard"`
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*a = Assurance(value.assurance)
+	if a.StandardName == "" {
+		a.StandardName = value.Standard
+	}
+	return nil
+}
+
+// Validate checks the properties required when the CarbonFootprint is assured
+// (coverage, level, boundary and providerName) are defined, and the statementOrSignature is valid
+func (a Assurance) Validate() error {
+	var errs []error
+	if a.Assurance {
+		for _, property := range []struct {
+			name  string
+			value string
+		}{
+			{"coverage", string(a.Coverage)},
+			{"level", string(a.Level)},
+			{"boundary", string(a.Boundary)},
+			{"providerName", a.ProviderName},
+		} {
+			if property.value == "" {
+				errs = append(errs, fmt.Errorf("%s: %w", property.name, ErrAssuranceRequired))
+			}
+		}
+	}
+
+	if a.StatementOrSignature != nil {
+		if err := a.StatementOrSignature.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("statementOrSignature: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
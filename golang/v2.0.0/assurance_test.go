@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssuranceUnmarshal(t *testing.T) {
+
+	var assurance Assurance
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"assurance": true,
+		"coverage": "product line",
+		"level": "reasonable",
+		"boundary": "Cradle-to-Gate",
+		"providerName": "My Auditor",
+		"completedAt": "2022-12-08T14:47:32Z",
+		"standard": "ISAE 3000",
+		"statementOrSignature": {
+			"type": "signature",
+			"value": "..."
+		},
+		"comments": "This is a comment"
+	}`), &assurance))
+
+	assert.Equal(t, ISAE3000, assurance.StandardName)
+	assert.True(t, assurance.StandardName.IsKnown())
+	assert.Equal(t, &Attachment{Type: SignatureAttachment, Value: "..."}, assurance.StatementOrSignature)
+	assert.Nil(t, assurance.Validate())
+
+	data, err := json.Marshal(assurance)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"standardName":"ISAE 3000"`)
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"standardName": "ISO 14064-3", "standard": "ISAE 3000"}`), &assurance))
+	assert.Equal(t, ISO14064Part3, assurance.StandardName)
+
+}
+
+func TestAssuranceValidate(t *testing.T) {
+
+	assert.Nil(t, Assurance{}.Validate())
+
+	err := Assurance{Assurance: true, Coverage: Product}.Validate()
+	assert.ErrorIs(t, err, ErrAssuranceRequired)
+	assert.EqualError(t, err, "level: required when assurance is true\n"+
+		"boundary: required when assurance is true\n"+
+		"providerName: required when assurance is true")
+
+	err = Assurance{StatementOrSignature: &Attachment{Type: StatementAttachment, URL: "https://example.com/statement.pdf"}}.Validate()
+	assert.ErrorIs(t, err, ErrAttachmentInvalid)
+	assert.EqualError(t, err, "statementOrSignature: invalid statementOrSignature: digest: required with url")
+
+	err = Attachment{Type: "report", Value: "...", URL: "https://example.com/statement.pdf"}.Validate()
+	assert.ErrorIs(t, err, ErrAttachmentTypeParse)
+	assert.ErrorIs(t, err, ErrAttachmentInvalid)
+
+}
+
+func TestAttachmentDigest(t *testing.T) {
+
+	content := []byte("statement")
+	attachment := NewAttachmentReference(StatementAttachment, "https://example.com/statement.pdf", content)
+	assert.Nil(t, attachment.Validate())
+	assert.Nil(t, attachment.VerifyDigest(content))
+	assert.ErrorIs(t, attachment.VerifyDigest([]byte("tampered")), ErrAttachmentDigest)
+
+	attachment.Digest = "md5:" + attachment.Digest[len("sha256:"):]
+	assert.ErrorIs(t, attachment.Validate(), ErrAttachmentInvalid)
+
+}
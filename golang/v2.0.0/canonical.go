@@ -0,0 +1,208 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Error when a JSON document can not be canonicalized
+var ErrCanonicalization = errors.New("JSON canonicalization failed")
+
+// CanonicalJSON returns the JSON Canonicalization Scheme (RFC 8785) form of a JSON document:
+// no whitespace, object members sorted by their UTF-16 code units, numbers serialized as
+// IEEE 754 doubles like ECMAScript and strings with minimal escaping.
+// The document must be a single I-JSON value (RFC 7493), without duplicate member names.
+func CanonicalJSON(data []byte) ([]byte, error) {
+	document, err := decodeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCanonicalization, err)
+	}
+	if err := checkDuplicateMembers(json.NewDecoder(bytes.NewReader(data))); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCanonicalization, err)
+	}
+
+	var buffer bytes.Buffer
+	if err := writeCanonical(&buffer, document); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Canonical returns the canonical JSON form (RFC 8785) of the ProductFootprint
+func (p ProductFootprint) Canonical() ([]byte, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return CanonicalJSON(data)
+}
+
+// checkDuplicateMembers reads the next JSON value of the decoder and fails
+// if one of its objects has several members with the same name
+func checkDuplicateMembers(decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		names := map[string]bool{}
+		for decoder.More() {
+			token, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			name := token.(string)
+			if names[name] {
+				return fmt.Errorf("duplicate member %q", name)
+			}
+			names[name] = true
+			if err := checkDuplicateMembers(decoder); err != nil {
+				return err
+			}
+		}
+		_, err = decoder.Token()
+		return err
+
+	case json.Delim('['):
+		for decoder.More() {
+			if err := checkDuplicateMembers(decoder); err != nil {
+				return err
+			}
+		}
+		_, err = decoder.Token()
+		return err
+	}
+	return nil
+}
+
+func writeCanonical(buffer *bytes.Buffer, value any) error {
+	switch typed := value.(type) {
+	case nil:
+		buffer.WriteString("null")
+	case bool:
+		buffer.WriteString(strconv.FormatBool(typed))
+	case string:
+		writeCanonicalString(buffer, typed)
+	case json.Number:
+		number, err := canonicalNumber(typed)
+		if err != nil {
+			return err
+		}
+		buffer.WriteString(number)
+	case []any:
+		buffer.WriteByte('[')
+		for i, item := range typed {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			if err := writeCanonical(buffer, item); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return lessUTF16(keys[i], keys[j])
+		})
+
+		buffer.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			writeCanonicalString(buffer, key)
+			buffer.WriteByte(':')
+			if err := writeCanonical(buffer, typed[key]); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte('}')
+	default:
+		return fmt.Errorf("%w: unsupported value %T", ErrCanonicalization, value)
+	}
+	return nil
+}
+
+// canonicalNumber serializes a number like ECMAScript Number.prototype.toString
+func canonicalNumber(number json.Number) (string, error) {
+	value, err := strconv.ParseFloat(number.String(), 64)
+	if err != nil || math.IsInf(value, 0) || math.IsNaN(value) {
+		return "", fmt.Errorf("%w: number %s out of range", ErrCanonicalization, number)
+	}
+	if value == 0 {
+		return "0", nil
+	}
+
+	format := byte('f')
+	if abs := math.Abs(value); abs < 1e-6 || abs >= 1e21 {
+		format = 'e'
+	}
+
+	text := strconv.FormatFloat(value, format, -1, 64)
+	if format == 'e' {
+		// e-07 is written e-7 and e+21 stays e+21
+		n := len(text)
+		if n >= 4 && text[n-4] == 'e' && text[n-2] == '0' {
+			text = text[:n-2] + text[n-1:]
+		}
+	}
+	return text, nil
+}
+
+func writeCanonicalString(buffer *bytes.Buffer, value string) {
+	const hex = "0123456789abcdef"
+
+	buffer.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"':
+			buffer.WriteString(`\"`)
+		case '\\':
+			buffer.WriteString(`\\`)
+		case '\b':
+			buffer.WriteString(`\b`)
+		case '\f':
+			buffer.WriteString(`\f`)
+		case '\n':
+			buffer.WriteString(`\n`)
+		case '\r':
+			buffer.WriteString(`\r`)
+		case '\t':
+			buffer.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				buffer.WriteString(`\u00`)
+				buffer.WriteByte(hex[r>>4])
+				buffer.WriteByte(hex[r&0xf])
+			} else {
+				var encoded [utf8.UTFMax]byte
+				buffer.Write(encoded[:utf8.EncodeRune(encoded[:], r)])
+			}
+		}
+	}
+	buffer.WriteByte('"')
+}
+
+// lessUTF16 compares two strings by their UTF-16 code units
+func lessUTF16(a, b string) bool {
+	x, y := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+	for i := 0; i < len(x) && i < len(y); i++ {
+		if x[i] != y[i] {
+			return x[i] < y[i]
+		}
+	}
+	return len(x) < len(y)
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+
+	canonical, err := CanonicalJSON([]byte(`{
+		"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001, -0],
+		"string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+		"literals": [null, true, false]
+	}`))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27,0],"string":"€$\u000f\nA'B\"\\\\\"/"}`, string(canonical))
+
+	canonical, err = CanonicalJSON([]byte(`{"\u20ac": 1, "\r": 2, "\ufb33": 3, "1": 4, "\ud83d\ude00": 5, "\u0080": 6, "\u00f6": 7}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"\\r\":2,\"1\":4,\"\u0080\":6,\"ö\":7,\"€\":1,\"😀\":5,\"\ufb33\":3}", string(canonical))
+
+	_, err = CanonicalJSON([]byte(`{"number": 1e400}`))
+	assert.ErrorIs(t, err, ErrCanonicalization)
+
+	// only a single I-JSON value is canonicalized
+	for _, data := range []string{
+		`{"pcf":1}{"pcf":999}`,
+		`{"pcf":1} 2`,
+		`[1] x`,
+		`{"pcf":1,"pcf":999}`,
+		`{"a":{"b":1,"\u0062":2}}`,
+		`[{"a":1},{"a":1,"a":2}]`,
+	} {
+		_, err = CanonicalJSON([]byte(data))
+		assert.ErrorIs(t, err, ErrCanonicalization, data)
+	}
+
+	canonical, err = CanonicalJSON([]byte(" {\"a\":{\"b\":1},\"b\":[{\"b\":2}]} \n"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"a":{"b":1},"b":[{"b":2}]}`, string(canonical))
+
+}
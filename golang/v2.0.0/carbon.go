@@ -12,7 +12,7 @@ type CarbonFootprint struct {
 	// See Data Type DeclaredUnit for further information.
 	//
 	// Mandatory
-	DeclaredUnit string `json:"declaredUnit"`
+	DeclaredUnit DeclaredUnit `json:"declaredUnit"`
 
 	// The amount of Declared Units contained within the product to which the PCF is referring to.
 	// The value MUST be strictly greater than 0.
@@ -33,8 +33,8 @@ type CarbonFootprint struct {
 	//
 	// Note: the value of this property can be less than 0 (zero).
 	//
-	// Optional
-	PCfIncludingBiogenic decimal.Decimal `json:"pCfIncludingBiogenic,omitempty"`
+	// Optional, nil when not reported
+	PCfIncludingBiogenic *decimal.Decimal `json:"pCfIncludingBiogenic,omitempty"`
 
 	// The emissions from fossil sources as a result of fuel combustion, from fugitive emissions,
 	// and from process emissions. The value MUST be calculated per declared unit with unit kg of CO2 equivalent
@@ -234,7 +234,7 @@ type CarbonFootprint struct {
 	//      FR-89
 	//
 	// Optional
-	GeographyCountrySubdivision string `json:"geographyCountrySubdivision,omitempty"`
+	GeographyCountrySubdivision ISO3166Subdivision `json:"geographyCountrySubdivision,omitempty"`
 
 	// If present, the value MUST conform to data type ISO3166CC.
 	// See § 4.2.1 Scope of a CarbonFootprint for further details.
@@ -243,7 +243,7 @@ type CarbonFootprint struct {
 	//     FR
 	//
 	// Optional
-	GeographyCountry string `json:"geographyCountry,omitempty"`
+	GeographyCountry ISO3166CC `json:"geographyCountry,omitempty"`
 
 	// If present, the value MUST conform to data type RegionOrSubregion.
 	// See § 4.2.1 Scope of a CarbonFootprint for further details.
@@ -283,7 +283,7 @@ type CarbonFootprint struct {
 	//      (e.g., attributional approach).
 	//
 	// Optional
-	SecondaryEmissionFactorSources []string `json:"secondaryEmissionFactorSources,omitempty"`
+	SecondaryEmissionFactorSources []EmissionFactorDS `json:"secondaryEmissionFactorSources,omitempty"`
 
 	// The Percentage of emissions excluded from PCF, expressed as a decimal number between 0.0 and 5 including.
 	// See Pathfinder Framework.
@@ -0,0 +1,148 @@
+package schema
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Error when two ProductFootprints do not refer to the same product
+var ErrDifferentProducts = errors.New("product footprints do not refer to the same product")
+
+// The variance (in percent) compared to the original PCF from which a change
+// is considered major and the PCF shall be recalculated and exchanged.
+// See Pathfinder Framework Section 6.1.2.1.
+const SignificantChangePercent = 10
+
+// ComparisonOptions configures the comparison of two ProductFootprints
+type ComparisonOptions struct {
+
+	// The product-specific parameters used to convert the current CarbonFootprint
+	// into the declared unit of the previous one
+	Conversion ConversionParameters
+
+	// If true, the emissions of the whole product (value x unitaryProductAmount)
+	// are compared instead of the emissions per declared unit
+	PerProduct bool
+
+	// The variance (in percent) from which a change is major,
+	// SignificantChangePercent if zero
+	Threshold decimal.Decimal
+}
+
+// FieldChange is the change of a property of the CarbonFootprint between two versions
+type FieldChange struct {
+
+	// The JSON name of the property
+	Field string `json:"field"`
+
+	Previous decimal.Decimal `json:"previous"`
+
+	Current decimal.Decimal `json:"current"`
+
+	// The relative change in percent, undefined if the previous value is zero
+	// and the current one is not
+	RelativeChange *decimal.Decimal `json:"relativeChange,omitempty"`
+
+	// Whether the change is equal to or greater than the threshold
+	Major bool `json:"major"`
+}
+
+// ChangeReport is the result of the comparison of two ProductFootprints
+type ChangeReport struct {
+
+	// The changes of every property calculated per declared unit
+	Changes []FieldChange `json:"changes"`
+
+	// Whether the PCF (pCfExcludingBiogenic or pCfIncludingBiogenic) varied by the threshold or more,
+	// in which case the PCF is no longer considered representative
+	Major bool `json:"major"`
+}
+
+// SameProduct reports whether two ProductFootprints refer to the same product,
+// either by sharing a ProductId or through their lineage (id and precedingPfIds).
+// Footprints without id are never matched through their lineage.
+func SameProduct(a, b ProductFootprint) bool {
+	if a.Id != uuid.Nil && a.Id == b.Id {
+		return true
+	}
+
+	for _, id := range a.PrecedingPfIds {
+		if id != uuid.Nil && id == b.Id {
+			return true
+		}
+	}
+
+	for _, id := range b.PrecedingPfIds {
+		if id != uuid.Nil && id == a.Id {
+			return true
+		}
+	}
+
+	for _, x := range a.ProductIds {
+		for _, y := range b.ProductIds {
+			if x.Equal(&y) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// CompareFootprints computes the relative change of every emission and carbon content property
+// between two versions of the footprint of the same product, and flags major changes.
+// The current CarbonFootprint is first converted into the declared unit of the previous one.
+func CompareFootprints(previous, current ProductFootprint, options ComparisonOptions) (ChangeReport, error) {
+	if !SameProduct(previous, current) {
+		return ChangeReport{}, ErrDifferentProducts
+	}
+
+	threshold := options.Threshold
+	if threshold.IsZero() {
+		threshold = decimal.NewFromInt(SignificantChangePercent)
+	}
+
+	before := previous.Pcf
+	after, err := ConvertCarbonFootprint(current.Pcf, before.DeclaredUnit, options.Conversion)
+	if err != nil {
+		return ChangeReport{}, err
+	}
+
+	var report ChangeReport
+
+	afterQuantities := after.perDeclaredUnitQuantities()
+	for i, quantity := range before.perDeclaredUnitQuantities() {
+		change := FieldChange{
+			Field:    quantity.name,
+			Previous: *quantity.value,
+			Current:  *afterQuantities[i].value,
+		}
+
+		if options.PerProduct {
+			change.Previous = change.Previous.Mul(before.UnitaryProductAmount)
+			change.Current = change.Current.Mul(after.UnitaryProductAmount)
+		}
+
+		switch {
+		case !change.Previous.IsZero():
+			relative := change.Current.Sub(change.Previous).Div(change.Previous.Abs()).Mul(hundred)
+			change.RelativeChange = &relative
+			change.Major = relative.Abs().GreaterThanOrEqual(threshold)
+		case !change.Current.IsZero():
+			change.Major = true
+		default:
+			relative := decimal.Zero
+			change.RelativeChange = &relative
+		}
+
+		if change.Major && (change.Field == "pCfExcludingBiogenic" || change.Field == "pCfIncludingBiogenic") {
+			report.Major = true
+		}
+
+		report.Changes = append(report.Changes, change)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,123 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/leodido/go-urn"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameProduct(t *testing.T) {
+
+	// footprints without id nor productIds are unrelated
+	assert.False(t, SameProduct(ProductFootprint{}, ProductFootprint{}))
+	assert.False(t, SameProduct(
+		ProductFootprint{PrecedingPfIds: []uuid.UUID{uuid.Nil}},
+		ProductFootprint{},
+	))
+
+	previous := ProductFootprint{Id: uuid.New()}
+	assert.True(t, SameProduct(previous, previous))
+
+	current := ProductFootprint{Id: uuid.New(), PrecedingPfIds: []uuid.UUID{uuid.New(), previous.Id}}
+	assert.True(t, SameProduct(previous, current))
+	assert.True(t, SameProduct(current, previous))
+	assert.False(t, SameProduct(previous, ProductFootprint{Id: uuid.New()}))
+
+	a := ProductFootprint{ProductIds: []urn.URN{mustURN(t, "urn:gtin:4712345060507")}}
+	b := ProductFootprint{ProductIds: []urn.URN{mustURN(t, "urn:uuid:51131fb5-42a2-4267-a402-0ecfefad1619"), mustURN(t, "urn:gtin:4712345060507")}}
+	assert.True(t, SameProduct(a, b))
+	assert.False(t, SameProduct(a, ProductFootprint{ProductIds: []urn.URN{mustURN(t, "urn:gtin:4712345060514")}}))
+
+}
+
+func TestCompareFootprintsThreshold(t *testing.T) {
+
+	previous := ProductFootprint{Id: uuid.New(), Pcf: CarbonFootprint{
+		DeclaredUnit:         KiloGram,
+		UnitaryProductAmount: decimal.NewFromInt(1),
+		PCfExcludingBiogenic: decimal.RequireFromString("1.0"),
+		FossilGhgEmissions:   decimal.RequireFromString("1.0"),
+	}}
+	current := ProductFootprint{Id: uuid.New(), PrecedingPfIds: []uuid.UUID{previous.Id}, Pcf: CarbonFootprint{
+		DeclaredUnit:         KiloGram,
+		UnitaryProductAmount: decimal.NewFromInt(1),
+		PCfExcludingBiogenic: decimal.RequireFromString("1.09"),
+		FossilGhgEmissions:   decimal.RequireFromString("1.2"),
+	}}
+
+	report, err := CompareFootprints(previous, current, ComparisonOptions{})
+	assert.Nil(t, err)
+	assert.False(t, report.Major)
+	changes := map[string]FieldChange{}
+	for _, change := range report.Changes {
+		changes[change.Field] = change
+	}
+	assert.True(t, changes["pCfExcludingBiogenic"].RelativeChange.Equal(decimal.NewFromInt(9)))
+	assert.False(t, changes["pCfExcludingBiogenic"].Major)
+	// only a change of the PCF makes the report major
+	assert.True(t, changes["fossilGhgEmissions"].RelativeChange.Equal(decimal.NewFromInt(20)))
+	assert.True(t, changes["fossilGhgEmissions"].Major)
+
+	// a variance of exactly 10% is major
+	current.Pcf.PCfExcludingBiogenic = decimal.RequireFromString("0.9")
+	report, err = CompareFootprints(previous, current, ComparisonOptions{})
+	assert.Nil(t, err)
+	assert.True(t, report.Major)
+
+	current.Pcf.PCfExcludingBiogenic = decimal.RequireFromString("1.09")
+	report, err = CompareFootprints(previous, current, ComparisonOptions{Threshold: decimal.NewFromInt(5)})
+	assert.Nil(t, err)
+	assert.True(t, report.Major)
+
+	_, err = CompareFootprints(previous, ProductFootprint{Id: uuid.New()}, ComparisonOptions{})
+	assert.ErrorIs(t, err, ErrDifferentProducts)
+
+}
+
+func TestCompareFootprintsNormalization(t *testing.T) {
+
+	productIds := []urn.URN{mustURN(t, "urn:gtin:4712345060507")}
+	previous := ProductFootprint{ProductIds: productIds, Pcf: CarbonFootprint{
+		DeclaredUnit:         Liter,
+		UnitaryProductAmount: decimal.NewFromInt(1000),
+		PCfExcludingBiogenic: decimal.RequireFromString("1.5"),
+	}}
+	current := ProductFootprint{ProductIds: productIds, Pcf: CarbonFootprint{
+		DeclaredUnit:         CubicMeter,
+		UnitaryProductAmount: decimal.RequireFromString("1.2"),
+		PCfExcludingBiogenic: decimal.NewFromInt(1500),
+	}}
+
+	// 1500 kgCO2e / m3 is 1.5 kgCO2e / l
+	report, err := CompareFootprints(previous, current, ComparisonOptions{})
+	assert.Nil(t, err)
+	assert.False(t, report.Major)
+	assert.True(t, report.Changes[0].Current.Equal(decimal.RequireFromString("1.5")))
+	assert.True(t, report.Changes[0].RelativeChange.IsZero())
+
+	// 1.2 m3 of product emit 1800 kgCO2e where 1000 l emitted 1500 kgCO2e
+	report, err = CompareFootprints(previous, current, ComparisonOptions{PerProduct: true})
+	assert.Nil(t, err)
+	assert.True(t, report.Major)
+	assert.True(t, report.Changes[0].Previous.Equal(decimal.NewFromInt(1500)))
+	assert.True(t, report.Changes[0].Current.Equal(decimal.NewFromInt(1800)))
+	assert.True(t, report.Changes[0].RelativeChange.Equal(decimal.NewFromInt(20)))
+
+	// converting between mass and volume needs the density
+	current.Pcf = CarbonFootprint{
+		DeclaredUnit:         KiloGram,
+		UnitaryProductAmount: decimal.NewFromInt(789),
+		PCfExcludingBiogenic: decimal.RequireFromString("1.9"),
+	}
+	_, err = CompareFootprints(previous, current, ComparisonOptions{})
+	assert.ErrorIs(t, err, ErrDensityRequired)
+
+	report, err = CompareFootprints(previous, current, ComparisonOptions{Conversion: ConversionParameters{Density: decimal.NewFromInt(789)}})
+	assert.Nil(t, err)
+	assert.True(t, report.Changes[0].Current.Round(4).Equal(decimal.RequireFromString("1.4991")))
+	assert.False(t, report.Major)
+
+}
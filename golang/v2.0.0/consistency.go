@@ -0,0 +1,145 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ConsistencyCheck identifies a relationship between the properties of a CarbonFootprint
+type ConsistencyCheck string
+
+const (
+	// pCfIncludingBiogenic equals pCfExcludingBiogenic plus the biogenic emissions and removals
+	// (dLucGhgEmissions, landManagementGhgEmissions, otherBiogenicGhgEmissions, biogenicCarbonWithdrawal)
+	BiogenicTotalCheck ConsistencyCheck = "biogenic total"
+
+	// fossilGhgEmissions does not exceed pCfExcludingBiogenic
+	FossilSubsetCheck ConsistencyCheck = "fossil subset"
+
+	// aircraftGhgEmissions does not exceed pCfExcludingBiogenic
+	AircraftSubsetCheck ConsistencyCheck = "aircraft subset"
+
+	// packagingGhgEmissions does not exceed pCfExcludingBiogenic
+	PackagingSubsetCheck ConsistencyCheck = "packaging subset"
+
+	// biogenicCarbonWithdrawal equals -44/12 of biogenicCarbonContent
+	BiogenicWithdrawalCheck ConsistencyCheck = "biogenic withdrawal"
+)
+
+// The ratio of the molar masses of CO2 and carbon
+var co2PerCarbon = decimal.NewFromInt(44).Div(decimal.NewFromInt(12))
+
+// Tolerance is the difference allowed when comparing two values,
+// two values a and b agree if |a - b| <= Absolute + Relative * max(|a|, |b|)
+type Tolerance struct {
+	Absolute decimal.Decimal
+	Relative decimal.Decimal
+}
+
+// within reports whether a and b agree within the tolerance
+func (t Tolerance) within(a, b decimal.Decimal) bool {
+	allowed := t.Absolute.Add(t.Relative.Mul(decimal.Max(a.Abs(), b.Abs())))
+	return a.Sub(b).Abs().LessThanOrEqual(allowed)
+}
+
+// ConsistencyOptions configures the tolerances of the consistency checks
+type ConsistencyOptions struct {
+
+	// The tolerance used by every check without a specific one
+	Default Tolerance
+
+	// The tolerances of specific checks
+	Checks map[ConsistencyCheck]Tolerance
+}
+
+// DefaultConsistencyOptions allows a 1% relative difference
+// and an absolute difference of 0.001 kg per declared unit
+var DefaultConsistencyOptions = ConsistencyOptions{
+	Default: Tolerance{
+		Absolute: decimal.New(1, -3),
+		Relative: decimal.New(1, -2),
+	},
+}
+
+func (o ConsistencyOptions) tolerance(check ConsistencyCheck) Tolerance {
+	if tolerance, ok := o.Checks[check]; ok {
+		return tolerance
+	}
+	return o.Default
+}
+
+// ConsistencyWarning reports a CarbonFootprint failing a consistency check
+type ConsistencyWarning struct {
+	Check    ConsistencyCheck `json:"check"`
+	Expected decimal.Decimal  `json:"expected"`
+	Actual   decimal.Decimal  `json:"actual"`
+	Message  string           `json:"message"`
+}
+
+func (w ConsistencyWarning) String() string {
+	return fmt.Sprintf("%s: %s", w.Check, w.Message)
+}
+
+// CheckConsistency evaluates the arithmetic relationships between the emission
+// properties of the CarbonFootprint and reports the suspicious ones as warnings.
+// The pCfIncludingBiogenic is checked only when reported, including when reported as 0.
+// The CarbonFootprint is consistent if no warnings are returned.
+func (c CarbonFootprint) CheckConsistency(options ConsistencyOptions) []ConsistencyWarning {
+	var warnings []ConsistencyWarning
+
+	if c.PCfIncludingBiogenic != nil {
+		biogenic := c.DLucGhgEmissions.
+			Add(c.LandManagementGhgEmissions).
+			Add(c.OtherBiogenicGhgEmissions).
+			Add(c.BiogenicCarbonWithdrawal)
+		expected := c.PCfExcludingBiogenic.Add(biogenic)
+		if !options.tolerance(BiogenicTotalCheck).within(expected, *c.PCfIncludingBiogenic) {
+			warnings = append(warnings, ConsistencyWarning{
+				Check:    BiogenicTotalCheck,
+				Expected: expected,
+				Actual:   *c.PCfIncludingBiogenic,
+				Message: fmt.Sprintf("pCfIncludingBiogenic %s differs from pCfExcludingBiogenic plus biogenic emissions and removals %s",
+					*c.PCfIncludingBiogenic, expected),
+			})
+		}
+	}
+
+	subsets := []struct {
+		check ConsistencyCheck
+		name  string
+		value decimal.Decimal
+	}{
+		{FossilSubsetCheck, "fossilGhgEmissions", c.FossilGhgEmissions},
+		{AircraftSubsetCheck, "aircraftGhgEmissions", c.AircraftGhgEmissions},
+		{PackagingSubsetCheck, "packagingGhgEmissions", c.PackagingGhgEmissions},
+	}
+	for _, subset := range subsets {
+		if subset.value.LessThanOrEqual(c.PCfExcludingBiogenic) ||
+			options.tolerance(subset.check).within(subset.value, c.PCfExcludingBiogenic) {
+			continue
+		}
+		warnings = append(warnings, ConsistencyWarning{
+			Check:    subset.check,
+			Expected: c.PCfExcludingBiogenic,
+			Actual:   subset.value,
+			Message: fmt.Sprintf("%s %s exceeds pCfExcludingBiogenic %s",
+				subset.name, subset.value, c.PCfExcludingBiogenic),
+		})
+	}
+
+	if !c.BiogenicCarbonWithdrawal.IsZero() {
+		expected := c.BiogenicCarbonContent.Mul(co2PerCarbon).Neg()
+		if !options.tolerance(BiogenicWithdrawalCheck).within(expected, c.BiogenicCarbonWithdrawal) {
+			warnings = append(warnings, ConsistencyWarning{
+				Check:    BiogenicWithdrawalCheck,
+				Expected: expected,
+				Actual:   c.BiogenicCarbonWithdrawal,
+				Message: fmt.Sprintf("biogenicCarbonWithdrawal %s differs from -44/12 of biogenicCarbonContent %s",
+					c.BiogenicCarbonWithdrawal, expected),
+			})
+		}
+	}
+
+	return warnings
+}
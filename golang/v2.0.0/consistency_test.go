@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func decimalPointer(value string) *decimal.Decimal {
+	d := decimal.RequireFromString(value)
+	return &d
+}
+
+func TestCheckConsistency(t *testing.T) {
+
+	footprint := CarbonFootprint{
+		PCfExcludingBiogenic:       decimal.NewFromInt(10),
+		PCfIncludingBiogenic:       decimalPointer("8.5"),
+		FossilGhgEmissions:         decimal.NewFromInt(9),
+		LandManagementGhgEmissions: decimal.RequireFromString("0.7"),
+		BiogenicCarbonContent:      decimal.RequireFromString("0.6"),
+		BiogenicCarbonWithdrawal:   decimal.RequireFromString("-2.2"),
+	}
+
+	warnings := footprint.CheckConsistency(DefaultConsistencyOptions)
+	assert.Empty(t, warnings)
+
+	footprint.PCfIncludingBiogenic = decimalPointer("10")
+	footprint.AircraftGhgEmissions = decimal.NewFromInt(11)
+
+	warnings = footprint.CheckConsistency(DefaultConsistencyOptions)
+	assert.Len(t, warnings, 2)
+	assert.Equal(t, BiogenicTotalCheck, warnings[0].Check)
+	assert.Equal(t, AircraftSubsetCheck, warnings[1].Check)
+
+	options := DefaultConsistencyOptions
+	options.Checks = map[ConsistencyCheck]Tolerance{
+		BiogenicTotalCheck: {Absolute: decimal.NewFromInt(2)},
+	}
+
+	warnings = footprint.CheckConsistency(options)
+	assert.Len(t, warnings, 1)
+	assert.Equal(t, AircraftSubsetCheck, warnings[0].Check)
+
+	// pCfIncludingBiogenic not reported
+	footprint.PCfIncludingBiogenic = nil
+	footprint.AircraftGhgEmissions = decimal.Zero
+	assert.Empty(t, footprint.CheckConsistency(DefaultConsistencyOptions))
+
+	// pCfIncludingBiogenic reported as 0
+	assert.Nil(t, json.Unmarshal([]byte(`{"pCfExcludingBiogenic": "10", "pCfIncludingBiogenic": "0"}`), &footprint))
+	warnings = footprint.CheckConsistency(DefaultConsistencyOptions)
+	assert.Len(t, warnings, 1)
+	assert.Equal(t, BiogenicTotalCheck, warnings[0].Check)
+	assert.True(t, warnings[0].Actual.IsZero())
+
+}
@@ -0,0 +1,194 @@
+package schema
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// Error when two declared units can not be converted into each other
+var ErrUnitConversionUnsupported = errors.New("unsupported DeclaredUnit conversion")
+
+// Error when a conversion requires the density of the product
+var ErrDensityRequired = errors.New("conversion requires a density greater than zero")
+
+// Error when a conversion requires the lower heating value of the product
+var ErrHeatingValueRequired = errors.New("conversion requires a lower heating value greater than zero")
+
+// The physical dimension measured by a DeclaredUnit
+type dimension int
+
+const (
+	mass dimension = iota
+	volume
+	energy
+	transport
+	area
+)
+
+// The dimension of every DeclaredUnit and the amount of the dimension base unit
+// (kilogram, cubic meter, megajoule, ton kilometer, square meter) it represents
+var unitDimensions = map[DeclaredUnit]struct {
+	dimension dimension
+	base      decimal.Decimal
+}{
+	KiloGram:     {mass, decimal.NewFromInt(1)},
+	Liter:        {volume, decimal.New(1, -3)},
+	CubicMeter:   {volume, decimal.NewFromInt(1)},
+	MegaJoule:    {energy, decimal.NewFromInt(1)},
+	KiloWattHour: {energy, decimal.New(36, -1)},
+	TonKilometer: {transport, decimal.NewFromInt(1)},
+	SquareMeter:  {area, decimal.NewFromInt(1)},
+}
+
+// ConversionParameters are the product-specific properties needed
+// to convert between declared units of different dimensions.
+type ConversionParameters struct {
+
+	// The density of the product in kg / m^3,
+	// needed to convert between volume (liter, cubic meter) and mass (kilogram).
+	//
+	// Optional
+	Density decimal.Decimal
+
+	// The lower heating value of the product in MJ / kg,
+	// needed to convert between mass (kilogram) and energy (megajoule, kilowatt hour).
+	//
+	// Optional
+	LowerHeatingValue decimal.Decimal
+}
+
+// toMass converts an amount of the dimension base unit into kilogram
+func (p ConversionParameters) toMass(amount decimal.Decimal, from dimension) (decimal.Decimal, error) {
+	switch from {
+	case mass:
+		return amount, nil
+	case volume:
+		if !p.Density.IsPositive() {
+			return decimal.Zero, ErrDensityRequired
+		}
+		return amount.Mul(p.Density), nil
+	case energy:
+		if !p.LowerHeatingValue.IsPositive() {
+			return decimal.Zero, ErrHeatingValueRequired
+		}
+		return amount.Div(p.LowerHeatingValue), nil
+	}
+	return decimal.Zero, ErrUnitConversionUnsupported
+}
+
+// fromMass converts an amount in kilogram into the dimension base unit
+func (p ConversionParameters) fromMass(amount decimal.Decimal, to dimension) (decimal.Decimal, error) {
+	switch to {
+	case mass:
+		return amount, nil
+	case volume:
+		if !p.Density.IsPositive() {
+			return decimal.Zero, ErrDensityRequired
+		}
+		return amount.Div(p.Density), nil
+	case energy:
+		if !p.LowerHeatingValue.IsPositive() {
+			return decimal.Zero, ErrHeatingValueRequired
+		}
+		return amount.Mul(p.LowerHeatingValue), nil
+	}
+	return decimal.Zero, ErrUnitConversionUnsupported
+}
+
+// ConversionFactor returns how many declared units to are equivalent to one declared unit from.
+//
+// Conversions within the same dimension (liter and cubic meter, kilowatt hour and megajoule)
+// are fixed, while conversions between volume, mass and energy go through the mass of the product
+// and require the Density and/or LowerHeatingValue parameters.
+func ConversionFactor(from, to DeclaredUnit, params ConversionParameters) (decimal.Decimal, error) {
+	source, ok := unitDimensions[from]
+	if !ok {
+		return decimal.Zero, ErrDeclaredUnitParse
+	}
+
+	target, ok := unitDimensions[to]
+	if !ok {
+		return decimal.Zero, ErrDeclaredUnitParse
+	}
+
+	amount := source.base
+	if source.dimension != target.dimension {
+		kilograms, err := params.toMass(amount, source.dimension)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		if amount, err = params.fromMass(kilograms, target.dimension); err != nil {
+			return decimal.Zero, err
+		}
+	}
+
+	return amount.Div(target.base), nil
+}
+
+// Convert converts an amount expressed in the declared unit from into the declared unit to
+func Convert(amount decimal.Decimal, from, to DeclaredUnit, params ConversionParameters) (decimal.Decimal, error) {
+	factor, err := ConversionFactor(from, to, params)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return amount.Mul(factor), nil
+}
+
+// namedQuantity is a property of the CarbonFootprint referenced by its JSON name
+type namedQuantity struct {
+	name  string
+	value *decimal.Decimal
+}
+
+// perDeclaredUnitQuantities returns all the properties of the CarbonFootprint
+// which are calculated per declared unit
+func (c *CarbonFootprint) perDeclaredUnitQuantities() []namedQuantity {
+	return []namedQuantity{
+		{"pCfExcludingBiogenic", &c.PCfExcludingBiogenic},
+		{"pCfIncludingBiogenic", c.includingBiogenic()},
+		{"fossilGhgEmissions", &c.FossilGhgEmissions},
+		{"fossilCarbonContent", &c.FossilCarbonContent},
+		{"biogenicCarbonContent", &c.BiogenicCarbonContent},
+		{"dLucGhgEmissions", &c.DLucGhgEmissions},
+		{"landManagementGhgEmissions", &c.LandManagementGhgEmissions},
+		{"otherBiogenicGhgEmissions", &c.OtherBiogenicGhgEmissions},
+		{"iLucGhgEmissions", &c.ILucGhgEmissions},
+		{"biogenicCarbonWithdrawal", &c.BiogenicCarbonWithdrawal},
+		{"aircraftGhgEmissions", &c.AircraftGhgEmissions},
+		{"packagingGhgEmissions", &c.PackagingGhgEmissions},
+	}
+}
+
+// includingBiogenic returns the pCfIncludingBiogenic of the CarbonFootprint, copied first so that
+// updating it leaves the footprints sharing the value untouched, or a zero value unrelated
+// to the CarbonFootprint if not reported
+func (c *CarbonFootprint) includingBiogenic() *decimal.Decimal {
+	if c.PCfIncludingBiogenic == nil {
+		return &decimal.Decimal{}
+	}
+	value := *c.PCfIncludingBiogenic
+	c.PCfIncludingBiogenic = &value
+	return c.PCfIncludingBiogenic
+}
+
+// ConvertCarbonFootprint re-expresses the CarbonFootprint in the declared unit to.
+// Every emission and carbon content property is rescaled to the new declared unit
+// and the UnitaryProductAmount is updated so that it still describes the same product.
+func ConvertCarbonFootprint(footprint CarbonFootprint, to DeclaredUnit, params ConversionParameters) (CarbonFootprint, error) {
+	factor, err := ConversionFactor(footprint.DeclaredUnit, to, params)
+	if err != nil {
+		return footprint, err
+	}
+
+	converted := footprint
+	for _, quantity := range converted.perDeclaredUnitQuantities() {
+		*quantity.value = quantity.value.Div(factor)
+	}
+
+	converted.UnitaryProductAmount = footprint.UnitaryProductAmount.Mul(factor)
+	converted.DeclaredUnit = to
+
+	return converted, nil
+}
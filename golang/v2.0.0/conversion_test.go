@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversionFactorFixed(t *testing.T) {
+
+	factor, err := ConversionFactor(KiloWattHour, MegaJoule, ConversionParameters{})
+	assert.Nil(t, err)
+	assert.True(t, factor.Equal(decimal.RequireFromString("3.6")))
+
+	factor, err = ConversionFactor(CubicMeter, Liter, ConversionParameters{})
+	assert.Nil(t, err)
+	assert.True(t, factor.Equal(decimal.NewFromInt(1000)))
+
+}
+
+func TestConversionFactorProductSpecific(t *testing.T) {
+
+	params := ConversionParameters{
+		Density:           decimal.NewFromInt(789),
+		LowerHeatingValue: decimal.NewFromInt(27),
+	}
+
+	factor, err := ConversionFactor(Liter, KiloGram, params)
+	assert.Nil(t, err)
+	assert.True(t, factor.Equal(decimal.RequireFromString("0.789")))
+
+	factor, err = ConversionFactor(KiloGram, KiloWattHour, params)
+	assert.Nil(t, err)
+	assert.True(t, factor.Equal(decimal.RequireFromString("7.5")))
+
+	_, err = ConversionFactor(Liter, KiloGram, ConversionParameters{})
+	assert.ErrorIs(t, err, ErrDensityRequired)
+
+	_, err = ConversionFactor(KiloGram, MegaJoule, ConversionParameters{})
+	assert.ErrorIs(t, err, ErrHeatingValueRequired)
+
+	_, err = ConversionFactor(KiloGram, TonKilometer, params)
+	assert.ErrorIs(t, err, ErrUnitConversionUnsupported)
+
+}
+
+func TestConvertCarbonFootprint(t *testing.T) {
+
+	footprint := CarbonFootprint{
+		DeclaredUnit:          Liter,
+		UnitaryProductAmount:  decimal.NewFromInt(12),
+		PCfExcludingBiogenic:  decimal.RequireFromString("1.578"),
+		FossilGhgEmissions:    decimal.RequireFromString("0.789"),
+		BiogenicCarbonContent: decimal.RequireFromString("0.3945"),
+	}
+
+	converted, err := ConvertCarbonFootprint(footprint, KiloGram, ConversionParameters{Density: decimal.NewFromInt(789)})
+	assert.Nil(t, err)
+
+	assert.Equal(t, KiloGram, converted.DeclaredUnit)
+	assert.True(t, converted.UnitaryProductAmount.Equal(decimal.RequireFromString("9.468")))
+	assert.True(t, converted.PCfExcludingBiogenic.Equal(decimal.NewFromInt(2)))
+	assert.True(t, converted.FossilGhgEmissions.Equal(decimal.NewFromInt(1)))
+	assert.True(t, converted.BiogenicCarbonContent.Equal(decimal.RequireFromString("0.5")))
+
+	// the original footprint is left untouched
+	assert.Equal(t, Liter, footprint.DeclaredUnit)
+	assert.True(t, footprint.PCfExcludingBiogenic.Equal(decimal.RequireFromString("1.578")))
+
+	// pCfIncludingBiogenic is converted only when reported
+	assert.Nil(t, converted.PCfIncludingBiogenic)
+	footprint.PCfIncludingBiogenic = decimalPointer("1.578")
+	converted, err = ConvertCarbonFootprint(footprint, KiloGram, ConversionParameters{Density: decimal.NewFromInt(789)})
+	assert.Nil(t, err)
+	assert.True(t, converted.PCfIncludingBiogenic.Equal(decimal.NewFromInt(2)))
+	assert.True(t, footprint.PCfIncludingBiogenic.Equal(decimal.RequireFromString("1.578")))
+
+}
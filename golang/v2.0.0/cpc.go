@@ -0,0 +1,237 @@
+package schema
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Error parsing the CPCCode
+var ErrCPCCodeParse = errors.New("unsupported CPCCode")
+
+// The codes of the UN Central Product Classification (CPC) Ver. 2.1 with their titles.
+// The embedded table lists the sections and divisions only: groups, classes and subclasses
+// are validated against their division and have no title until the complete table is
+// regenerated with internal/classgen from the UNSD structure file.
+//
+//go:embed data/cpc21.csv
+var cpcCSV string
+
+var cpcCodes = loadCPC(cpcCSV)
+
+var cpcFormat = regexp.MustCompile(`^[0-9]{1,5}$`)
+
+// cpcTable is the embedded UN CPC table
+type cpcTable struct {
+
+	// The title of every code of the table
+	titles map[string]string
+
+	// The most detailed level of the table
+	depth CPCLevel
+}
+
+func loadCPC(table string) cpcTable {
+	records, err := csv.NewReader(strings.NewReader(table)).ReadAll()
+	if err != nil {
+		panic("invalid UN CPC table: " + err.Error())
+	}
+
+	codes := cpcTable{titles: map[string]string{}}
+	for _, record := range records[1:] {
+		if !cpcFormat.MatchString(record[0]) {
+			panic("invalid UN CPC table: code " + record[0])
+		}
+		codes.titles[record[0]] = record[1]
+		codes.depth = max(codes.depth, CPCCode(record[0]).Level())
+	}
+	return codes
+}
+
+// contains reports whether the code is listed in the table, or, for a code more detailed
+// than the table, whether its ancestor of the most detailed level of the table is listed
+func (t cpcTable) contains(code CPCCode) bool {
+	if !cpcFormat.MatchString(string(code)) {
+		return false
+	}
+	_, ok := t.titles[string(code.Ancestor(min(code.Level(), t.depth)))]
+	return ok
+}
+
+// CPCLevel is a level of the UN CPC hierarchy, the number of digits of its codes
+type CPCLevel int
+
+const (
+	// 1 digit, e.g. 3
+	CPCSection CPCLevel = iota + 1
+
+	// 2 digits, e.g. 33
+	CPCDivision
+
+	// 3 digits, e.g. 334
+	CPCGroup
+
+	// 4 digits, e.g. 3342
+	CPCClass
+
+	// 5 digits, e.g. 33420
+	CPCSubclass
+)
+
+// CPCCode is a UN Central Product Classification (CPC) Ver. 2.1 code, e.g. 3342
+type CPCCode string
+
+// ParseCPCCode returns the CPCCode with the given value,
+// or an *EnumParseError matching ErrCPCCodeParse
+func ParseCPCCode(value string) (CPCCode, error) {
+	if !CPCCode(value).IsValid() {
+		err := &EnumParseError{Type: "CPCCode", Value: value, sentinel: ErrCPCCodeParse}
+		if suggestion := strings.NewReplacer(" ", "", ".", "").Replace(value); CPCCode(suggestion).IsValid() {
+			err.Suggestion = suggestion
+		}
+		return "", err
+	}
+	return CPCCode(value), nil
+}
+
+// IsValid reports whether the value is a code of the embedded UN CPC table.
+// Codes more detailed than the embedded table must be part of one of its codes,
+// e.g. with the sections and divisions only, any group, class or subclass of a listed division is valid.
+func (u CPCCode) IsValid() bool {
+	return cpcCodes.contains(u)
+}
+
+// Level returns the level of the code in the UN CPC hierarchy
+func (u CPCCode) Level() CPCLevel {
+	return CPCLevel(len(u))
+}
+
+// Ancestor returns the code of the given level the code is part of,
+// the code itself for its own level, or the empty string for a lower level
+func (u CPCCode) Ancestor(level CPCLevel) CPCCode {
+	if level < CPCSection || level > u.Level() {
+		return ""
+	}
+	return u[:level]
+}
+
+// Section returns the section of the code, e.g. 3 for 3342
+func (u CPCCode) Section() CPCCode {
+	return u.Ancestor(CPCSection)
+}
+
+// Division returns the division of the code, e.g. 33 for 3342
+func (u CPCCode) Division() CPCCode {
+	return u.Ancestor(CPCDivision)
+}
+
+// Group returns the group of the code, e.g. 334 for 3342
+func (u CPCCode) Group() CPCCode {
+	return u.Ancestor(CPCGroup)
+}
+
+// Contains reports whether the code other is u or part of u
+func (u CPCCode) Contains(other CPCCode) bool {
+	return u != "" && strings.HasPrefix(string(other), string(u))
+}
+
+// Title returns the title of the code, or the empty string if it is not part of the embedded table
+func (u CPCCode) Title() string {
+	return cpcCodes.titles[string(u)]
+}
+
+func (u CPCCode) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u CPCCode) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		_, err := ParseCPCCode(string(u))
+		return nil, err
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *CPCCode) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseCPCCode(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u CPCCode) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *CPCCode) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+// GroupByCPC groups the footprints by the code of the given level of their productCategoryCpc,
+// e.g. by division for category-level reporting.
+// Footprints with a less detailed productCategoryCpc are grouped under the empty code.
+func GroupByCPC(footprints []ProductFootprint, level CPCLevel) map[CPCCode][]ProductFootprint {
+	groups := map[CPCCode][]ProductFootprint{}
+	for _, footprint := range footprints {
+		code := footprint.ProductCategoryCpc.Ancestor(level)
+		groups[code] = append(groups[code], footprint)
+	}
+	return groups
+}
+
+// FilterByCPC returns the footprints whose productCategoryCpc is part of one of the codes
+func FilterByCPC(footprints []ProductFootprint, codes ...CPCCode) []ProductFootprint {
+	var filtered []ProductFootprint
+	for _, footprint := range footprints {
+		for _, code := range codes {
+			if code.Contains(footprint.ProductCategoryCpc) {
+				filtered = append(filtered, footprint)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// CPCCodes returns the sorted distinct codes of the given level of the footprints
+func CPCCodes(footprints []ProductFootprint, level CPCLevel) []CPCCode {
+	var codes []CPCCode
+	for code := range GroupByCPC(footprints, level) {
+		if code != "" {
+			codes = append(codes, code)
+		}
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		return codes[i] < codes[j]
+	})
+	return codes
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCPCCode(t *testing.T) {
+
+	code, err := ParseCPCCode("3342")
+	assert.Nil(t, err)
+	assert.Equal(t, CPCClass, code.Level())
+	assert.Equal(t, CPCCode("3"), code.Section())
+	assert.Equal(t, CPCCode("33"), code.Division())
+	assert.Equal(t, CPCCode("334"), code.Group())
+	assert.Equal(t, CPCCode(""), code.Ancestor(CPCSubclass))
+	assert.Equal(t, "Coke oven products; refined petroleum products; nuclear fuel", code.Division().Title())
+	assert.True(t, CPCCode("33").Contains(code))
+	assert.False(t, CPCCode("34").Contains(code))
+
+	for _, value := range []string{"", "5", "53", "01111", "99000"} {
+		_, err := ParseCPCCode(value)
+		if value == "" {
+			assert.ErrorIs(t, err, ErrCPCCodeParse)
+		} else {
+			assert.Nil(t, err, value)
+		}
+	}
+
+	for _, value := range []string{"50", "10", "334200", "33a"} {
+		_, err := ParseCPCCode(value)
+		assert.ErrorIs(t, err, ErrCPCCodeParse, value)
+	}
+
+	var product ProductFootprint
+	err = Unmarshal([]byte(`{"productCategoryCpc": "33.42"}`), &product)
+	assert.EqualError(t, err, `productCategoryCpc: unsupported CPCCode "33.42", did you mean "3342"?`)
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"productCategoryCpc": "3342"}`), &product))
+	assert.Equal(t, CPCCode("3342"), product.ProductCategoryCpc)
+
+	// the zero value round-trips
+	for _, data := range []string{`{"productCategoryCpc": ""}`, `{"productCategoryCpc": null}`} {
+		product.ProductCategoryCpc = "3342"
+		assert.Nil(t, json.Unmarshal([]byte(data), &product), data)
+		assert.Equal(t, CPCCode(""), product.ProductCategoryCpc, data)
+	}
+	encoded, err := json.Marshal(CPCCode(""))
+	assert.Nil(t, err)
+	var decoded CPCCode
+	assert.Nil(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, CPCCode(""), decoded)
+
+}
+
+func TestGroupByCPC(t *testing.T) {
+
+	footprints := []ProductFootprint{
+		{ProductCategoryCpc: "3342"},
+		{ProductCategoryCpc: "33420"},
+		{ProductCategoryCpc: "3410"},
+		{ProductCategoryCpc: "4"},
+	}
+
+	groups := GroupByCPC(footprints, CPCDivision)
+	assert.Len(t, groups["33"], 2)
+	assert.Len(t, groups["34"], 1)
+	assert.Len(t, groups[""], 1)
+
+	assert.Equal(t, []CPCCode{"3", "4"}, CPCCodes(footprints, CPCSection))
+	assert.Len(t, FilterByCPC(footprints, "33", "4"), 3)
+	assert.Empty(t, FilterByCPC(footprints, "2"))
+
+}
+
+func TestCPCTableMembership(t *testing.T) {
+
+	// a table with subclasses validates the membership of every level
+	table := loadCPC("code,title\n" +
+		"0,\"Agriculture, forestry and fishery products\"\n" +
+		"01,\"Products of agriculture, horticulture and market gardening\"\n" +
+		"011,Cereals\n" +
+		"0111,Wheat\n" +
+		"01111,\"Wheat, seed\"\n")
+	assert.Equal(t, CPCSubclass, table.depth)
+	assert.Equal(t, "Wheat", table.titles["0111"])
+
+	for _, code := range []CPCCode{"0", "01", "011", "0111", "01111"} {
+		assert.True(t, table.contains(code), code)
+	}
+	for _, code := range []CPCCode{"1", "02", "012", "0112", "01112", "011111", ""} {
+		assert.False(t, table.contains(code), code)
+	}
+
+}
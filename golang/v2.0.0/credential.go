@@ -0,0 +1,433 @@
+package schema
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Error when the ProductFootprint has no company identifier to derive the issuer from
+var ErrCredentialIssuer = errors.New("no company identifier to derive the credential issuer from")
+
+// Error when the issuer of a credential is not one of the company identifiers of its ProductFootprint
+var ErrCredentialIssuerMismatch = errors.New("credential issuer is not a company identifier of the footprint")
+
+// Error when a JSON document is not a verifiable credential with a Data Integrity proof
+var ErrCredentialFormat = errors.New("invalid verifiable credential")
+
+// Error when the verification method of a proof can not be resolved
+var ErrUnknownVerificationMethod = errors.New("unknown verification method")
+
+// Error when a did:key is not a multibase encoded Ed25519 public key
+var ErrDIDKeyFormat = errors.New("invalid did:key, Ed25519 public key expected")
+
+// The JSON-LD context of the W3C Verifiable Credentials Data Model v2.0
+const CredentialsContext = "https://www.w3.org/ns/credentials/v2"
+
+// The types of a ProductFootprint verifiable credential
+const (
+	VerifiableCredentialType       = "VerifiableCredential"
+	ProductFootprintCredentialType = "ProductFootprintCredential"
+)
+
+// The Data Integrity proof supported
+const (
+	DataIntegrityProofType = "DataIntegrityProof"
+	EdDSAJCS2022           = "eddsa-jcs-2022"
+	AssertionMethod        = "assertionMethod"
+)
+
+// The multicodec prefix of an Ed25519 public key
+var ed25519Multicodec = []byte{0xed, 0x01}
+
+// The issuer identifier schemes in order of preference, buyer-assigned codes do not identify the issuer
+var issuerSchemes = []IdentifierScheme{LEIScheme, SGLNScheme, UUIDScheme, CompanyVendorAssignedScheme}
+
+// VerifiableCredential is a W3C Verifiable Credential whose subject is a ProductFootprint
+type VerifiableCredential struct {
+
+	// The JSON-LD contexts, starting with CredentialsContext
+	//
+	// Mandatory
+	Context []string `json:"@context"`
+
+	// The URN of the ProductFootprint id, e.g. urn:uuid:d9be4477-e351-45b3-acd9-e1da05e6f633
+	//
+	// Optional
+	Id string `json:"id,omitempty"`
+
+	// The types of the credential, including VerifiableCredentialType
+	//
+	// Mandatory
+	Type []string `json:"type"`
+
+	// The URI of the issuer, derived from a company identifier of the ProductFootprint
+	//
+	// Mandatory
+	Issuer string `json:"issuer"`
+
+	// The time the credential was issued
+	//
+	// Optional
+	ValidFrom *time.Time `json:"validFrom,omitempty"`
+
+	// The ProductFootprint
+	//
+	// Mandatory
+	CredentialSubject ProductFootprint `json:"credentialSubject"`
+
+	// The Data Integrity proof of the credential
+	//
+	// Optional
+	Proof *DataIntegrityProof `json:"proof,omitempty"`
+}
+
+// DataIntegrityProof is a W3C Data Integrity proof using the eddsa-jcs-2022 cryptosuite
+type DataIntegrityProof struct {
+
+	// The JSON-LD contexts of the secured document
+	//
+	// Optional
+	Context []string `json:"@context,omitempty"`
+
+	// DataIntegrityProofType
+	//
+	// Mandatory
+	Type string `json:"type"`
+
+	// EdDSAJCS2022
+	//
+	// Mandatory
+	Cryptosuite string `json:"cryptosuite"`
+
+	// The time the proof was created
+	//
+	// Mandatory
+	Created time.Time `json:"created"`
+
+	// The URL of the public key, e.g. did:key:z6Mk...#z6Mk...
+	//
+	// Mandatory
+	VerificationMethod string `json:"verificationMethod"`
+
+	// AssertionMethod
+	//
+	// Mandatory
+	ProofPurpose string `json:"proofPurpose"`
+
+	// The multibase (base58btc) encoded Ed25519 signature
+	//
+	// Mandatory
+	ProofValue string `json:"proofValue"`
+}
+
+// CredentialIssuer returns the issuer of the credential of the ProductFootprint: the first valid
+// company identifier, preferring a LEI, then a SGLN, a UUID and a vendor-assigned code
+func CredentialIssuer(p ProductFootprint) (string, error) {
+	identifiers, _ := p.CompanyIdentifiers()
+	for _, scheme := range issuerSchemes {
+		for _, identifier := range identifiers {
+			if identifier.Scheme == scheme {
+				return identifier.String(), nil
+			}
+		}
+	}
+	return "", ErrCredentialIssuer
+}
+
+// isCredentialIssuer reports whether the issuer is a valid company identifier of the ProductFootprint
+// of one of the issuer identifier schemes
+func isCredentialIssuer(p ProductFootprint, issuer string) bool {
+	identifiers, _ := p.CompanyIdentifiers()
+	for _, identifier := range identifiers {
+		if identifier.String() == issuer && slices.Contains(issuerSchemes, identifier.Scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewCredential wraps the ProductFootprint in an unsecured verifiable credential
+func NewCredential(p ProductFootprint) (VerifiableCredential, error) {
+	issuer, err := CredentialIssuer(p)
+	if err != nil {
+		return VerifiableCredential{}, err
+	}
+	return VerifiableCredential{
+		Context:           []string{CredentialsContext},
+		Id:                "urn:uuid:" + p.Id.String(),
+		Type:              []string{VerifiableCredentialType, ProductFootprintCredentialType},
+		Issuer:            issuer,
+		CredentialSubject: p,
+	}, nil
+}
+
+// Footprint returns the ProductFootprint the credential is about
+func (c VerifiableCredential) Footprint() ProductFootprint {
+	return c.CredentialSubject
+}
+
+// CredentialSigner issues ProductFootprint credentials secured by an Ed25519 key
+type CredentialSigner struct {
+	verificationMethod string
+	key                ed25519.PrivateKey
+}
+
+// NewCredentialSigner returns a CredentialSigner with the URL the verifiers resolve the public key with.
+// An empty verification method defaults to the did:key of the public key.
+func NewCredentialSigner(verificationMethod string, key ed25519.PrivateKey) (*CredentialSigner, error) {
+	if len(key) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("%w: private key has %d bytes", ErrUnsupportedKey, len(key))
+	}
+	if verificationMethod == "" {
+		verificationMethod = DIDKeyVerificationMethod(key.Public().(ed25519.PublicKey))
+	}
+	return &CredentialSigner{verificationMethod: verificationMethod, key: key}, nil
+}
+
+// Issue wraps the ProductFootprint in a verifiable credential secured by an eddsa-jcs-2022 proof
+func (s *CredentialSigner) Issue(p ProductFootprint) (VerifiableCredential, error) {
+	credential, err := NewCredential(p)
+	if err != nil {
+		return VerifiableCredential{}, err
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	credential.ValidFrom = &now
+
+	document, err := json.Marshal(credential)
+	if err != nil {
+		return VerifiableCredential{}, err
+	}
+	proof := DataIntegrityProof{
+		Context:            credential.Context,
+		Type:               DataIntegrityProofType,
+		Cryptosuite:        EdDSAJCS2022,
+		Created:            now,
+		VerificationMethod: s.verificationMethod,
+		ProofPurpose:       AssertionMethod,
+	}
+	encodedProof, err := json.Marshal(proof)
+	if err != nil {
+		return VerifiableCredential{}, err
+	}
+
+	input, err := proofInput(encodedProof, document)
+	if err != nil {
+		return VerifiableCredential{}, err
+	}
+	proof.ProofValue = "z" + encodeBase58(ed25519.Sign(s.key, input))
+	credential.Proof = &proof
+	return credential, nil
+}
+
+// proofInput returns the SHA-256 hash of the canonical proof configuration, the proof
+// without its value, followed by the SHA-256 hash of the canonical unsecured document
+func proofInput(proof, document []byte) ([]byte, error) {
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(proof, &config); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCredentialFormat, err)
+	}
+	delete(config, "proofValue")
+
+	encodedConfig, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	canonicalConfig, err := CanonicalJSON(encodedConfig)
+	if err != nil {
+		return nil, err
+	}
+	canonicalDocument, err := CanonicalJSON(document)
+	if err != nil {
+		return nil, err
+	}
+	configHash, documentHash := sha256.Sum256(canonicalConfig), sha256.Sum256(canonicalDocument)
+	return append(configHash[:], documentHash[:]...), nil
+}
+
+// verificationKey is a public key and the controller allowed to issue credentials with it
+type verificationKey struct {
+	controller string
+	key        ed25519.PublicKey
+}
+
+// CredentialResolver resolves verification methods to public keys offline, from a locally
+// configured set of keys only: a did:key verification method is not trusted until added with AddDIDKey.
+// The zero value is not usable, use NewCredentialResolver.
+type CredentialResolver struct {
+	methods map[string]verificationKey
+}
+
+// NewCredentialResolver returns an empty CredentialResolver
+func NewCredentialResolver() *CredentialResolver {
+	return &CredentialResolver{methods: map[string]verificationKey{}}
+}
+
+// Add adds the Ed25519 public key of a verification method controlled by the issuer
+func (r *CredentialResolver) Add(issuer, verificationMethod string, key ed25519.PublicKey) error {
+	if len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("%w: %s has %d bytes", ErrUnsupportedKey, verificationMethod, len(key))
+	}
+	r.methods[verificationMethod] = verificationKey{controller: issuer, key: key}
+	return nil
+}
+
+// AddDIDKey adds the verification method of a did:key controlled by the issuer
+func (r *CredentialResolver) AddDIDKey(issuer, did string) error {
+	key, err := ParseDIDKey(did)
+	if err != nil {
+		return err
+	}
+	return r.Add(issuer, DIDKeyVerificationMethod(key), key)
+}
+
+// Resolve returns the controller and the public key of a configured verification method
+func (r *CredentialResolver) Resolve(verificationMethod string) (string, ed25519.PublicKey, error) {
+	method, ok := r.methods[verificationMethod]
+	if !ok {
+		return "", nil, fmt.Errorf("%w: %q", ErrUnknownVerificationMethod, verificationMethod)
+	}
+	return method.controller, method.key, nil
+}
+
+// Verify verifies the eddsa-jcs-2022 proof of the JSON verifiable credential: the issuer is
+// a company identifier of the ProductFootprint, the verification method is configured as controlled
+// by the issuer, and the signature
+func (r *CredentialResolver) Verify(data []byte) (VerifiableCredential, error) {
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(data, &document); err != nil {
+		return VerifiableCredential{}, fmt.Errorf("%w: %w", ErrCredentialFormat, err)
+	}
+	var credential VerifiableCredential
+	if err := json.Unmarshal(data, &credential); err != nil {
+		return VerifiableCredential{}, err
+	}
+	if !slices.Contains(credential.Type, VerifiableCredentialType) {
+		return VerifiableCredential{}, fmt.Errorf("%w: type %s missing", ErrCredentialFormat, VerifiableCredentialType)
+	}
+
+	proof := credential.Proof
+	switch {
+	case proof == nil:
+		return VerifiableCredential{}, fmt.Errorf("%w: proof missing", ErrCredentialFormat)
+	case proof.Type != DataIntegrityProofType || proof.Cryptosuite != EdDSAJCS2022:
+		return VerifiableCredential{}, fmt.Errorf("%w: unsupported proof %s %s", ErrCredentialFormat, proof.Type, proof.Cryptosuite)
+	case proof.ProofPurpose != AssertionMethod:
+		return VerifiableCredential{}, fmt.Errorf("%w: unsupported proof purpose %q", ErrCredentialFormat, proof.ProofPurpose)
+	case proof.Context != nil && !slices.Equal(proof.Context, credential.Context):
+		return VerifiableCredential{}, fmt.Errorf("%w: proof context differs from the credential context", ErrCredentialFormat)
+	}
+
+	if !isCredentialIssuer(credential.CredentialSubject, credential.Issuer) {
+		return VerifiableCredential{}, fmt.Errorf("%w: %s", ErrCredentialIssuerMismatch, credential.Issuer)
+	}
+
+	controller, key, err := r.Resolve(proof.VerificationMethod)
+	if err != nil {
+		return VerifiableCredential{}, err
+	}
+	if controller != credential.Issuer {
+		return VerifiableCredential{}, fmt.Errorf("%w: %s is not controlled by the issuer %s", ErrSignatureInvalid, proof.VerificationMethod, credential.Issuer)
+	}
+
+	encodedProof := document["proof"]
+	delete(document, "proof")
+	encodedDocument, err := json.Marshal(document)
+	if err != nil {
+		return VerifiableCredential{}, err
+	}
+	input, err := proofInput(encodedProof, encodedDocument)
+	if err != nil {
+		return VerifiableCredential{}, err
+	}
+
+	signature, err := decodeMultibase(proof.ProofValue)
+	if err != nil || !ed25519.Verify(key, input, signature) {
+		return VerifiableCredential{}, ErrSignatureInvalid
+	}
+	return credential, nil
+}
+
+// Unwrap verifies the JSON verifiable credential and returns its ProductFootprint
+func (r *CredentialResolver) Unwrap(data []byte) (ProductFootprint, error) {
+	credential, err := r.Verify(data)
+	if err != nil {
+		return ProductFootprint{}, err
+	}
+	return credential.Footprint(), nil
+}
+
+// DIDKey returns the did:key of an Ed25519 public key, e.g. did:key:z6Mk...
+func DIDKey(key ed25519.PublicKey) string {
+	return "did:key:z" + encodeBase58(append(slices.Clone(ed25519Multicodec), key...))
+}
+
+// DIDKeyVerificationMethod returns the verification method of the did:key of an Ed25519 public key
+func DIDKeyVerificationMethod(key ed25519.PublicKey) string {
+	did := DIDKey(key)
+	return did + "#" + strings.TrimPrefix(did, "did:key:")
+}
+
+// ParseDIDKey returns the Ed25519 public key of a did:key
+func ParseDIDKey(did string) (ed25519.PublicKey, error) {
+	value, ok := strings.CutPrefix(did, "did:key:")
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrDIDKeyFormat, did)
+	}
+	decoded, err := decodeMultibase(value)
+	if err != nil || !bytes.HasPrefix(decoded, ed25519Multicodec) || len(decoded) != len(ed25519Multicodec)+ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%w: %q", ErrDIDKeyFormat, did)
+	}
+	return ed25519.PublicKey(decoded[len(ed25519Multicodec):]), nil
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// encodeBase58 encodes bytes with the bitcoin base58 alphabet, leading zero bytes are encoded as 1
+func encodeBase58(data []byte) string {
+	number := new(big.Int).SetBytes(data)
+	radix, remainder := big.NewInt(58), new(big.Int)
+
+	var encoded []byte
+	for number.Sign() > 0 {
+		number.DivMod(number, radix, remainder)
+		encoded = append(encoded, base58Alphabet[remainder.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+	slices.Reverse(encoded)
+	return string(encoded)
+}
+
+// decodeMultibase decodes a base58btc multibase value, starting with z
+func decodeMultibase(value string) ([]byte, error) {
+	encoded, ok := strings.CutPrefix(value, "z")
+	if !ok || encoded == "" {
+		return nil, errors.New("base58btc multibase expected")
+	}
+
+	number, radix := new(big.Int), big.NewInt(58)
+	zeros := 0
+	for i, c := range []byte(encoded) {
+		digit := strings.IndexByte(base58Alphabet, c)
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", c)
+		}
+		if digit == 0 && zeros == i {
+			zeros++
+		}
+		number.Mul(number, radix).Add(number, big.NewInt(int64(digit)))
+	}
+	return append(make([]byte, zeros), number.Bytes()...), nil
+}
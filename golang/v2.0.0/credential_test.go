@@ -0,0 +1,178 @@
+package schema
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/leodido/go-urn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialIssuer(t *testing.T) {
+
+	footprint := testFootprint()
+	_, err := CredentialIssuer(footprint)
+	assert.ErrorIs(t, err, ErrCredentialIssuer)
+
+	footprint.CompanyIds = []urn.URN{
+		mustURN(t, "urn:pathfinder:company:customcode:buyer-assigned:1234"),
+		mustURN(t, "urn:epc:id:sgln:4063973.00000.8"),
+		mustURN(t, "urn:lei:5493001KJTIIGC8Y1R13"),
+	}
+	issuer, err := CredentialIssuer(footprint)
+	assert.Nil(t, err)
+	assert.Equal(t, "urn:epc:id:sgln:4063973.00000.8", issuer)
+
+	footprint.CompanyIds = append(footprint.CompanyIds, mustURN(t, "urn:lei:5493001KJTIIGC8Y1R12"))
+	issuer, err = CredentialIssuer(footprint)
+	assert.Nil(t, err)
+	assert.Equal(t, "urn:lei:5493001KJTIIGC8Y1R12", issuer)
+
+}
+
+func TestDIDKey(t *testing.T) {
+
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+
+	did := DIDKey(public)
+	assert.True(t, strings.HasPrefix(did, "did:key:z6Mk"), did)
+	assert.Equal(t, did+"#"+strings.TrimPrefix(did, "did:key:"), DIDKeyVerificationMethod(public))
+
+	parsed, err := ParseDIDKey(did)
+	assert.Nil(t, err)
+	assert.Equal(t, public, parsed)
+
+	for _, invalid := range []string{"did:web:example.com", "did:key:6Mk", "did:key:z0OIl", "did:key:z6LSbysY2xFMRpGMhb7tFTLMpeuPRaqaWM1yECx2AtzE3KCc"} {
+		_, err := ParseDIDKey(invalid)
+		assert.ErrorIs(t, err, ErrDIDKeyFormat, invalid)
+	}
+
+	// leading zero bytes are kept
+	encoded := encodeBase58([]byte{0, 0, 1, 2})
+	assert.Equal(t, "11", encoded[:2])
+	decoded, err := decodeMultibase("z" + encoded)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0, 0, 1, 2}, decoded)
+
+}
+
+func TestIssueVerifyCredential(t *testing.T) {
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	issuer := "urn:lei:5493001KJTIIGC8Y1R12"
+
+	footprint := testFootprint()
+	footprint.CompanyIds = []urn.URN{mustURN(t, issuer)}
+
+	signer, err := NewCredentialSigner("", private)
+	assert.Nil(t, err)
+	credential, err := signer.Issue(footprint)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{CredentialsContext}, credential.Context)
+	assert.Equal(t, "urn:uuid:"+footprint.Id.String(), credential.Id)
+	assert.Equal(t, issuer, credential.Issuer)
+	assert.Equal(t, EdDSAJCS2022, credential.Proof.Cryptosuite)
+	assert.Equal(t, DIDKeyVerificationMethod(public), credential.Proof.VerificationMethod)
+	assert.True(t, strings.HasPrefix(credential.Proof.ProofValue, "z"))
+
+	data, err := json.MarshalIndent(credential, "", "  ")
+	assert.Nil(t, err)
+
+	// the did:key is not trusted until configured
+	resolver := NewCredentialResolver()
+	_, err = resolver.Verify(data)
+	assert.ErrorIs(t, err, ErrUnknownVerificationMethod)
+
+	assert.Nil(t, resolver.AddDIDKey(issuer, DIDKey(public)))
+	verified, err := resolver.Verify(data)
+	assert.Nil(t, err)
+	assert.Equal(t, issuer, verified.Issuer)
+
+	unwrapped, err := resolver.Unwrap(data)
+	assert.Nil(t, err)
+	assert.Equal(t, footprint.Id, unwrapped.Id)
+	assert.True(t, footprint.Pcf.PCfExcludingBiogenic.Equal(unwrapped.Pcf.PCfExcludingBiogenic))
+
+	tampered := bytes.Replace(data, []byte(`"1.5"`), []byte(`"1.4"`), 1)
+	assert.NotEqual(t, data, tampered)
+	_, err = resolver.Verify(tampered)
+	assert.ErrorIs(t, err, ErrSignatureInvalid)
+
+	other, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	assert.Nil(t, resolver.Add(issuer, DIDKeyVerificationMethod(public), other))
+	_, err = resolver.Verify(data)
+	assert.ErrorIs(t, err, ErrSignatureInvalid)
+
+	signer, err = NewCredentialSigner("https://example.com/keys#1", private)
+	assert.Nil(t, err)
+	credential, err = signer.Issue(footprint)
+	assert.Nil(t, err)
+	data, err = json.Marshal(credential)
+	assert.Nil(t, err)
+	_, err = resolver.Verify(data)
+	assert.ErrorIs(t, err, ErrUnknownVerificationMethod)
+	assert.ErrorIs(t, resolver.Add(issuer, "https://example.com/keys#1", ed25519.PublicKey{1}), ErrUnsupportedKey)
+	_, err = resolver.Verify(data)
+	assert.ErrorIs(t, err, ErrUnknownVerificationMethod)
+	assert.Nil(t, resolver.Add(issuer, "https://example.com/keys#1", public))
+	_, err = resolver.Verify(data)
+	assert.Nil(t, err)
+
+	credential.Proof = nil
+	data, err = json.Marshal(credential)
+	assert.Nil(t, err)
+	_, err = resolver.Verify(data)
+	assert.ErrorIs(t, err, ErrCredentialFormat)
+
+	for _, key := range []ed25519.PrivateKey{nil, private[:10], append(private, 0)} {
+		_, err = NewCredentialSigner("https://example.com/keys#1", key)
+		assert.ErrorIs(t, err, ErrUnsupportedKey)
+	}
+
+}
+
+func TestVerifyCredentialUntrusted(t *testing.T) {
+
+	_, attacker, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	issuer := "urn:lei:5493001KJTIIGC8Y1R12"
+
+	footprint := testFootprint()
+	footprint.CompanyIds = []urn.URN{mustURN(t, issuer)}
+
+	// a credential signed with an unknown did:key does not verify against an empty resolver
+	signer, err := NewCredentialSigner("", attacker)
+	assert.Nil(t, err)
+	credential, err := signer.Issue(footprint)
+	assert.Nil(t, err)
+	data, err := json.Marshal(credential)
+	assert.Nil(t, err)
+	_, err = NewCredentialResolver().Unwrap(data)
+	assert.ErrorIs(t, err, ErrUnknownVerificationMethod)
+
+	// nor when the attacker issues it as its own did:key
+	resolver := NewCredentialResolver()
+	did := DIDKey(attacker.Public().(ed25519.PublicKey))
+	assert.Nil(t, resolver.AddDIDKey(did, did))
+	credential.Issuer = did
+	data, err = json.Marshal(credential)
+	assert.Nil(t, err)
+	_, err = resolver.Verify(data)
+	assert.ErrorIs(t, err, ErrCredentialIssuerMismatch)
+
+	// the issuer must be a company identifier of the footprint
+	assert.Nil(t, resolver.AddDIDKey("urn:lei:5493001KJTIIGC8Y1R13", did))
+	credential.Issuer = "urn:lei:5493001KJTIIGC8Y1R13"
+	data, err = json.Marshal(credential)
+	assert.Nil(t, err)
+	_, err = resolver.Verify(data)
+	assert.ErrorIs(t, err, ErrCredentialIssuerMismatch)
+
+}
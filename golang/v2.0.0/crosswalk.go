@@ -0,0 +1,318 @@
+package schema
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Error parsing the HSCode
+var ErrHSCodeParse = errors.New("unsupported HSCode")
+
+// Error parsing the CNCode
+var ErrCNCodeParse = errors.New("unsupported CNCode")
+
+// Error reading a CPC to HS correspondence table
+var ErrCrosswalkFormat = errors.New("invalid CPC to HS correspondence table")
+
+// Error when a code is not part of the CPC to HS correspondence table
+var ErrCrosswalkNotCovered = errors.New("code not covered by the CPC to HS correspondence table")
+
+// A partial sample of the correspondence between CPC Ver. 2.1 subclasses and HS 2022 subheadings,
+// covering the CPC subclasses 17100, 34210, 3461x, 3744x and 411xx only.
+// The complete table is regenerated with internal/classgen from the UNSD HS 2022 to CPC Ver. 2.1
+// correspondence, use LoadCrosswalk to read another correspondence table.
+//
+//go:embed data/cpc21-hs2022.csv
+var cpcHSCSV string
+
+var defaultCrosswalk = mustLoadCrosswalk(cpcHSCSV)
+
+var hsFormat = regexp.MustCompile(`^[0-9]{4}([0-9]{2})?$`)
+
+var cnFormat = regexp.MustCompile(`^[0-9]{8}$`)
+
+// HSCode is a Harmonized System 2022 heading (4 digits, e.g. 2523)
+// or subheading (6 digits, e.g. 252329)
+type HSCode string
+
+// ParseHSCode returns the HSCode with the given value,
+// or an *EnumParseError matching ErrHSCodeParse
+func ParseHSCode(value string) (HSCode, error) {
+	if !HSCode(value).IsValid() {
+		err := &EnumParseError{Type: "HSCode", Value: value, sentinel: ErrHSCodeParse}
+		if suggestion := strings.NewReplacer(" ", "", ".", "").Replace(value); HSCode(suggestion).IsValid() {
+			err.Suggestion = suggestion
+		}
+		return "", err
+	}
+	return HSCode(value), nil
+}
+
+// IsValid reports whether the value is formatted as an HS heading or subheading
+func (u HSCode) IsValid() bool {
+	return hsFormat.MatchString(string(u))
+}
+
+// Heading returns the 4 digits heading of the code, e.g. 2523 for 252329
+func (u HSCode) Heading() HSCode {
+	if len(u) < 4 {
+		return ""
+	}
+	return u[:4]
+}
+
+func (u HSCode) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u HSCode) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		_, err := ParseHSCode(string(u))
+		return nil, err
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *HSCode) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseHSCode(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u HSCode) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *HSCode) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+// CNCode is an EU Combined Nomenclature code (8 digits, e.g. 25232900),
+// the HS subheading followed by 2 digits of EU subdivision.
+//
+// There is no embedded CN table: CN codes are validated for their format only,
+// and their correspondence is derived from their HS subheading.
+type CNCode string
+
+// ParseCNCode returns the CNCode with the given value,
+// or an *EnumParseError matching ErrCNCodeParse
+func ParseCNCode(value string) (CNCode, error) {
+	if !CNCode(value).IsValid() {
+		err := &EnumParseError{Type: "CNCode", Value: value, sentinel: ErrCNCodeParse}
+		if suggestion := strings.NewReplacer(" ", "", ".", "").Replace(value); CNCode(suggestion).IsValid() {
+			err.Suggestion = suggestion
+		}
+		return "", err
+	}
+	return CNCode(value), nil
+}
+
+// IsValid reports whether the value is formatted as a CN code,
+// the code is not checked against the Combined Nomenclature
+func (u CNCode) IsValid() bool {
+	return cnFormat.MatchString(string(u))
+}
+
+// HS returns the HS subheading of the code, e.g. 252329 for 25232900
+func (u CNCode) HS() HSCode {
+	if len(u) < 6 {
+		return ""
+	}
+	return HSCode(u[:6])
+}
+
+func (u CNCode) String() string {
+	return string(u)
+}
+
+// HSCorrespondence is the result of a lookup of the HS codes of a CPC code
+type HSCorrespondence struct {
+
+	// The CPC code looked up
+	CPC CPCCode
+
+	// The sorted HS subheadings corresponding to the CPC code
+	Codes []HSCode
+
+	// The sorted distinct HS headings of the codes
+	Headings []HSCode
+
+	// Whether the CPC code corresponds to several HS headings,
+	// in which case the heading can not be derived from the CPC code alone
+	Ambiguous bool
+}
+
+// CPCCorrespondence is the result of a lookup of the CPC codes of an HS code
+type CPCCorrespondence struct {
+
+	// The HS code looked up
+	HS HSCode
+
+	// The sorted CPC subclasses corresponding to the HS code
+	Codes []CPCCode
+
+	// Whether the HS code corresponds to several CPC subclasses
+	Ambiguous bool
+}
+
+// Crosswalk is a many-to-many correspondence between CPC subclasses and HS subheadings
+type Crosswalk struct {
+	hs  map[CPCCode][]HSCode
+	cpc map[HSCode][]CPCCode
+}
+
+// DefaultCrosswalk returns the embedded sample of the CPC Ver. 2.1 to HS 2022 correspondence
+func DefaultCrosswalk() *Crosswalk {
+	return defaultCrosswalk
+}
+
+// LoadCrosswalk reads a CPC to HS correspondence table in CSV format
+// with a header and two columns: the CPC subclass and the HS subheading.
+// Codes may contain dots or spaces, e.g. 2523.29.
+func LoadCrosswalk(r io.Reader) (*Crosswalk, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCrosswalkFormat, err)
+	}
+	if len(records) == 0 {
+		return nil, ErrCrosswalkFormat
+	}
+
+	c := &Crosswalk{hs: map[CPCCode][]HSCode{}, cpc: map[HSCode][]CPCCode{}}
+	clean := strings.NewReplacer(" ", "", ".", "")
+	for i, record := range records[1:] {
+		if len(record) < 2 {
+			return nil, fmt.Errorf("%w: line %d", ErrCrosswalkFormat, i+2)
+		}
+
+		cpc, err := ParseCPCCode(clean.Replace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrCrosswalkFormat, i+2, err)
+		}
+		hs, err := ParseHSCode(clean.Replace(record[1]))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrCrosswalkFormat, i+2, err)
+		}
+
+		c.hs[cpc] = append(c.hs[cpc], hs)
+		c.cpc[hs] = append(c.cpc[hs], cpc)
+	}
+
+	return c, nil
+}
+
+func mustLoadCrosswalk(table string) *Crosswalk {
+	c, err := LoadCrosswalk(strings.NewReader(table))
+	if err != nil {
+		panic(err.Error())
+	}
+	return c
+}
+
+// HS returns the HS codes corresponding to the CPC code.
+// For a section, division, group or class, the codes of all its subclasses are returned.
+// It fails with ErrCrosswalkNotCovered if no subclass of the code is part of the table.
+func (c *Crosswalk) HS(code CPCCode) (HSCorrespondence, error) {
+	result := HSCorrespondence{CPC: code}
+
+	codes := map[HSCode]bool{}
+	headings := map[HSCode]bool{}
+	for cpc, hs := range c.hs {
+		if !code.Contains(cpc) {
+			continue
+		}
+		for _, value := range hs {
+			codes[value] = true
+			headings[value.Heading()] = true
+		}
+	}
+
+	if len(codes) == 0 {
+		return result, fmt.Errorf("%w: CPC %q", ErrCrosswalkNotCovered, code)
+	}
+
+	result.Codes = sortedCodes(codes)
+	result.Headings = sortedCodes(headings)
+	result.Ambiguous = len(result.Headings) > 1
+	return result, nil
+}
+
+// CPC returns the CPC subclasses corresponding to the HS code.
+// For a heading, the subclasses of all its subheadings are returned.
+// It fails with ErrCrosswalkNotCovered if no subheading of the code is part of the table.
+func (c *Crosswalk) CPC(code HSCode) (CPCCorrespondence, error) {
+	result := CPCCorrespondence{HS: code}
+
+	codes := map[CPCCode]bool{}
+	for hs, cpc := range c.cpc {
+		if code == "" || !strings.HasPrefix(string(hs), string(code)) {
+			continue
+		}
+		for _, value := range cpc {
+			codes[value] = true
+		}
+	}
+
+	if len(codes) == 0 {
+		return result, fmt.Errorf("%w: HS %q", ErrCrosswalkNotCovered, code)
+	}
+
+	result.Codes = sortedCodes(codes)
+	result.Ambiguous = len(result.Codes) > 1
+	return result, nil
+}
+
+// CN returns the CPC subclasses corresponding to the HS subheading of the CN code,
+// the 2 digits of EU subdivision are not taken into account
+func (c *Crosswalk) CN(code CNCode) (CPCCorrespondence, error) {
+	return c.CPC(code.HS())
+}
+
+// HS returns the HS codes corresponding to the CPC code in the embedded correspondence
+func (u CPCCode) HS() (HSCorrespondence, error) {
+	return defaultCrosswalk.HS(u)
+}
+
+func sortedCodes[T ~string](set map[T]bool) []T {
+	codes := make([]T, 0, len(set))
+	for code := range set {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		return codes[i] < codes[j]
+	})
+	return codes
+}
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCPCToHS(t *testing.T) {
+
+	cement, err := CPCCode("37442").HS()
+	assert.Nil(t, err)
+	assert.Equal(t, []HSCode{"252321", "252329"}, cement.Codes)
+	assert.Equal(t, []HSCode{"2523"}, cement.Headings)
+	assert.False(t, cement.Ambiguous)
+
+	gases, err := CPCCode("34210").HS()
+	assert.Nil(t, err)
+	assert.Equal(t, []HSCode{"2804", "2811"}, gases.Headings)
+	assert.True(t, gases.Ambiguous)
+
+	steel, err := CPCCode("411").HS()
+	assert.Nil(t, err)
+	assert.Equal(t, []HSCode{"7201", "7202", "7203", "7207", "7218", "7224"}, steel.Headings)
+
+	// the embedded sample does not cover every subclass
+	_, err = CPCCode("3342").HS()
+	assert.ErrorIs(t, err, ErrCrosswalkNotCovered)
+	assert.EqualError(t, err, `code not covered by the CPC to HS correspondence table: CPC "3342"`)
+
+}
+
+func TestHSToCPC(t *testing.T) {
+
+	crosswalk := DefaultCrosswalk()
+
+	cement, err := crosswalk.CN("25232900")
+	assert.Nil(t, err)
+	assert.Equal(t, []CPCCode{"37442"}, cement.Codes)
+
+	cements, err := crosswalk.CPC("2523")
+	assert.Nil(t, err)
+	assert.Equal(t, []CPCCode{"37441", "37442", "37449"}, cements.Codes)
+	assert.True(t, cements.Ambiguous)
+
+	for _, code := range []HSCode{"0101", ""} {
+		_, err = crosswalk.CPC(code)
+		assert.ErrorIs(t, err, ErrCrosswalkNotCovered, code)
+	}
+	_, err = crosswalk.CN("01012100")
+	assert.ErrorIs(t, err, ErrCrosswalkNotCovered)
+
+	_, err = ParseCNCode("2523 29 00")
+	assert.ErrorIs(t, err, ErrCNCodeParse)
+	assert.EqualError(t, err, `unsupported CNCode "2523 29 00", did you mean "25232900"?`)
+
+	// the zero value round-trips
+	for _, data := range []string{`""`, `null`} {
+		code := HSCode("2523")
+		assert.Nil(t, json.Unmarshal([]byte(data), &code), data)
+		assert.Equal(t, HSCode(""), code, data)
+	}
+	data, err := json.Marshal(HSCode(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+}
+
+func TestLoadCrosswalk(t *testing.T) {
+
+	crosswalk, err := LoadCrosswalk(strings.NewReader("cpc,hs\n01234,0101.21\n01235,0101.21\n01235,0102.29\n"))
+	assert.Nil(t, err)
+	horses, err := crosswalk.CPC("010121")
+	assert.Nil(t, err)
+	assert.Equal(t, []CPCCode{"01234", "01235"}, horses.Codes)
+	assert.True(t, horses.Ambiguous)
+	animals, err := crosswalk.HS("01235")
+	assert.Nil(t, err)
+	assert.True(t, animals.Ambiguous)
+
+	_, err = LoadCrosswalk(strings.NewReader("cpc,hs\n01234,01\n"))
+	assert.ErrorIs(t, err, ErrCrosswalkFormat)
+	assert.ErrorIs(t, err, ErrHSCodeParse)
+
+}
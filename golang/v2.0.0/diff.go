@@ -0,0 +1,371 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	urn "github.com/leodido/go-urn"
+	"github.com/shopspring/decimal"
+)
+
+type DiffOperation string
+
+const (
+	// the property or set element is only defined in the new ProductFootprint
+	Added DiffOperation = "added"
+
+	// the property or set element is only defined in the old ProductFootprint
+	Removed DiffOperation = "removed"
+
+	// the property is defined in both ProductFootprints with different values
+	Changed DiffOperation = "changed"
+)
+
+// DiffEntry is a difference between two ProductFootprints
+type DiffEntry struct {
+
+	// The path of the property using the JSON names, e.g. pcf.dqi.temporalDQR.
+	// Set elements and extensions are referenced by value and dataSchema
+	// e.g. productIds[urn:gtin:4712345060507], while other arrays use the index.
+	Path string `json:"path"`
+
+	Operation DiffOperation `json:"op"`
+
+	Old any `json:"old,omitempty"`
+
+	New any `json:"new,omitempty"`
+}
+
+func (e DiffEntry) String() string {
+	switch e.Operation {
+	case Added:
+		return fmt.Sprintf("+ %s: %v", e.Path, e.New)
+	case Removed:
+		return fmt.Sprintf("- %s: %v", e.Path, e.Old)
+	}
+	return fmt.Sprintf("~ %s: %v -> %v", e.Path, e.Old, e.New)
+}
+
+// Diff is the list of differences between two ProductFootprints,
+// it can be rendered as JSON with encoding/json or as text with String
+type Diff []DiffEntry
+
+func (d Diff) String() string {
+	var builder strings.Builder
+	for _, entry := range d {
+		builder.WriteString(entry.String())
+		builder.WriteByte('\n')
+	}
+	return builder.String()
+}
+
+// The properties compared as sets, regardless of the order of their elements
+var diffSets = map[string]bool{
+	"precedingPfIds":                 true,
+	"companyIds":                     true,
+	"productIds":                     true,
+	"pcf.crossSectoralStandardsUsed": true,
+}
+
+var (
+	decimalType   = reflect.TypeOf(decimal.Decimal{})
+	timeType      = reflect.TypeOf(time.Time{})
+	urnType       = reflect.TypeOf(urn.URN{})
+	extensionType = reflect.TypeOf(DataModelExtension{})
+)
+
+// DiffProductFootprints returns the semantic differences between two versions of a ProductFootprint.
+// Decimals and timestamps are compared by value, the identifiers and cross-sectoral standards as sets,
+// and the extensions are matched by dataSchema.
+func DiffProductFootprints(before, after ProductFootprint) Diff {
+	var diff Diff
+	diff.values("", reflect.ValueOf(before), reflect.ValueOf(after))
+	return diff
+}
+
+func (d *Diff) add(path string, before, after reflect.Value) {
+	beforeZero, afterZero := isZeroValue(before), isZeroValue(after)
+	switch {
+	case beforeZero && afterZero:
+	case beforeZero:
+		*d = append(*d, DiffEntry{Path: path, Operation: Added, New: diffValue(after)})
+	case afterZero:
+		*d = append(*d, DiffEntry{Path: path, Operation: Removed, Old: diffValue(before)})
+	default:
+		*d = append(*d, DiffEntry{Path: path, Operation: Changed, Old: diffValue(before), New: diffValue(after)})
+	}
+}
+
+func isZeroValue(value reflect.Value) bool {
+	if value.Type() == decimalType {
+		// an omitted decimal and an explicit zero are considered the same
+		return value.Interface().(decimal.Decimal).IsZero()
+	}
+	return value.IsZero()
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func (d *Diff) values(path string, before, after reflect.Value) {
+	switch before.Type() {
+	case decimalType:
+		if !before.Interface().(decimal.Decimal).Equal(after.Interface().(decimal.Decimal)) {
+			d.add(path, before, after)
+		}
+		return
+	case timeType:
+		if !before.Interface().(time.Time).Equal(after.Interface().(time.Time)) {
+			d.add(path, before, after)
+		}
+		return
+	case urnType:
+		if setKey(before) != setKey(after) {
+			d.add(path, before, after)
+		}
+		return
+	}
+
+	switch before.Kind() {
+	case reflect.Struct:
+		for i := 0; i < before.NumField(); i++ {
+			field := before.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			d.values(joinPath(path, name), before.Field(i), after.Field(i))
+		}
+	case reflect.Slice:
+		switch {
+		case before.Type().Elem() == extensionType:
+			d.extensions(path, before, after)
+		case diffSets[path]:
+			d.sets(path, before, after)
+		case before.Type().Elem().Kind() == reflect.Uint8:
+			if !bytes.Equal(before.Bytes(), after.Bytes()) {
+				d.add(path, before, after)
+			}
+		default:
+			d.arrays(path, before, after)
+		}
+	case reflect.Map:
+		d.maps(path, before, after)
+	case reflect.Pointer:
+		switch {
+		case before.IsNil() && after.IsNil():
+		case before.IsNil() || after.IsNil():
+			d.add(path, before, after)
+		default:
+			d.values(path, before.Elem(), after.Elem())
+		}
+	case reflect.Interface:
+		d.json(path, before.Interface(), after.Interface())
+	default:
+		if !before.Equal(after) {
+			d.add(path, before, after)
+		}
+	}
+}
+
+func setKey(value reflect.Value) string {
+	if value.Type() == urnType {
+		return canonicalURN(value.Interface().(urn.URN))
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// canonicalURN returns the URN with its NID in lower case and,
+// for the case-insensitive UUIDs, its NSS in lower case too
+func canonicalURN(u urn.URN) string {
+	normalized := u.Normalize()
+	if normalized.ID == "uuid" {
+		normalized.SS = strings.ToLower(normalized.SS)
+	}
+	return normalized.String()
+}
+
+// diffValue returns the value reported in a DiffEntry, URNs are reported as strings
+func diffValue(value reflect.Value) any {
+	if value.Type() == urnType {
+		u := value.Interface().(urn.URN)
+		return u.String()
+	}
+	return value.Interface()
+}
+
+func (d *Diff) sets(path string, before, after reflect.Value) {
+	beforeKeys := map[string]reflect.Value{}
+	for i := 0; i < before.Len(); i++ {
+		beforeKeys[setKey(before.Index(i))] = before.Index(i)
+	}
+
+	afterKeys := map[string]reflect.Value{}
+	for i := 0; i < after.Len(); i++ {
+		afterKeys[setKey(after.Index(i))] = after.Index(i)
+	}
+
+	for _, key := range sortedKeys(beforeKeys) {
+		if _, ok := afterKeys[key]; !ok {
+			*d = append(*d, DiffEntry{Path: path + "[" + key + "]", Operation: Removed, Old: diffValue(beforeKeys[key])})
+		}
+	}
+
+	for _, key := range sortedKeys(afterKeys) {
+		if _, ok := beforeKeys[key]; !ok {
+			*d = append(*d, DiffEntry{Path: path + "[" + key + "]", Operation: Added, New: diffValue(afterKeys[key])})
+		}
+	}
+}
+
+func (d *Diff) arrays(path string, before, after reflect.Value) {
+	for i := 0; i < before.Len() || i < after.Len(); i++ {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= after.Len():
+			*d = append(*d, DiffEntry{Path: elementPath, Operation: Removed, Old: diffValue(before.Index(i))})
+		case i >= before.Len():
+			*d = append(*d, DiffEntry{Path: elementPath, Operation: Added, New: diffValue(after.Index(i))})
+		default:
+			d.values(elementPath, before.Index(i), after.Index(i))
+		}
+	}
+}
+
+func (d *Diff) maps(path string, before, after reflect.Value) {
+	keys := map[string]reflect.Value{}
+	for _, key := range before.MapKeys() {
+		keys[fmt.Sprint(key.Interface())] = key
+	}
+	for _, key := range after.MapKeys() {
+		keys[fmt.Sprint(key.Interface())] = key
+	}
+
+	for _, name := range sortedKeys(keys) {
+		key := keys[name]
+		elementPath := joinPath(path, name)
+		beforeValue, afterValue := before.MapIndex(key), after.MapIndex(key)
+		switch {
+		case !afterValue.IsValid():
+			*d = append(*d, DiffEntry{Path: elementPath, Operation: Removed, Old: diffValue(beforeValue)})
+		case !beforeValue.IsValid():
+			*d = append(*d, DiffEntry{Path: elementPath, Operation: Added, New: diffValue(afterValue)})
+		default:
+			d.values(elementPath, beforeValue, afterValue)
+		}
+	}
+}
+
+func (d *Diff) extensions(path string, before, after reflect.Value) {
+	beforeExtensions := map[string]DataModelExtension{}
+	for i := 0; i < before.Len(); i++ {
+		extension := before.Index(i).Interface().(DataModelExtension)
+		beforeExtensions[extension.DataSchema] = extension
+	}
+
+	afterExtensions := map[string]DataModelExtension{}
+	for i := 0; i < after.Len(); i++ {
+		extension := after.Index(i).Interface().(DataModelExtension)
+		afterExtensions[extension.DataSchema] = extension
+	}
+
+	for _, schema := range sortedKeys(beforeExtensions) {
+		if _, ok := afterExtensions[schema]; !ok {
+			*d = append(*d, DiffEntry{Path: path + "[" + schema + "]", Operation: Removed, Old: beforeExtensions[schema]})
+		}
+	}
+
+	for _, schema := range sortedKeys(afterExtensions) {
+		extensionPath := path + "[" + schema + "]"
+		afterExtension := afterExtensions[schema]
+		beforeExtension, ok := beforeExtensions[schema]
+		if !ok {
+			*d = append(*d, DiffEntry{Path: extensionPath, Operation: Added, New: afterExtension})
+			continue
+		}
+
+		d.values(joinPath(extensionPath, "specVersion"),
+			reflect.ValueOf(beforeExtension.SpecVersion), reflect.ValueOf(afterExtension.SpecVersion))
+
+		dataPath := joinPath(extensionPath, "data")
+		beforeEncoded, beforeErr := beforeExtension.Encoded()
+		afterEncoded, afterErr := afterExtension.Encoded()
+		beforeData, beforeDecodeErr := decodeJSON(beforeEncoded)
+		afterData, afterDecodeErr := decodeJSON(afterEncoded)
+		if beforeErr != nil || afterErr != nil || beforeDecodeErr != nil || afterDecodeErr != nil {
+			if !bytes.Equal(beforeEncoded, afterEncoded) {
+				*d = append(*d, DiffEntry{Path: dataPath, Operation: Changed, Old: beforeEncoded, New: afterEncoded})
+			}
+			continue
+		}
+		d.json(dataPath, beforeData, afterData)
+	}
+}
+
+func decodeJSON(data json.RawMessage) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("invalid data after top-level value")
+	}
+	return value, nil
+}
+
+// json compares two decoded JSON documents, numbers are compared numerically
+func (d *Diff) json(path string, before, after any) {
+	switch beforeValue := before.(type) {
+	case map[string]any:
+		if afterValue, ok := after.(map[string]any); ok {
+			d.maps(path, reflect.ValueOf(beforeValue), reflect.ValueOf(afterValue))
+			return
+		}
+	case []any:
+		if afterValue, ok := after.([]any); ok {
+			d.arrays(path, reflect.ValueOf(beforeValue), reflect.ValueOf(afterValue))
+			return
+		}
+	case json.Number:
+		if afterValue, ok := after.(json.Number); ok {
+			a, errA := decimal.NewFromString(beforeValue.String())
+			b, errB := decimal.NewFromString(afterValue.String())
+			if errA == nil && errB == nil && a.Equal(b) {
+				return
+			}
+		}
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		*d = append(*d, DiffEntry{Path: path, Operation: Changed, Old: before, New: after})
+	}
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
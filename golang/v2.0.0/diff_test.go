@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	urn "github.com/leodido/go-urn"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustURN(t *testing.T, value string) urn.URN {
+	u, ok := urn.Parse([]byte(value))
+	assert.True(t, ok, value)
+	return *u
+}
+
+func TestDiffProductFootprints(t *testing.T) {
+
+	old := ProductFootprint{
+		Version:    1,
+		CompanyIds: []urn.URN{mustURN(t, "urn:lei:1234"), mustURN(t, "urn:uuid:51131fb5-42a2-4267-a402-0ecfefad1619")},
+		ProductIds: []urn.URN{mustURN(t, "urn:gtin:4712345060507")},
+		Pcf: CarbonFootprint{
+			PCfExcludingBiogenic:       decimal.RequireFromString("1.50"),
+			FossilGhgEmissions:         decimal.RequireFromString("1.2"),
+			CrossSectoralStandardsUsed: []Standard{GHGProtocol, ISO14067},
+		},
+		Extensions: []DataModelExtension{
+			{SpecVersion: "2.0.0", DataSchema: "https://example.com/a", Data: json.RawMessage(`{"weight": 10, "id": "A"}`)},
+			{SpecVersion: "2.0.0", DataSchema: "https://example.com/b", Data: json.RawMessage(`{}`)},
+		},
+	}
+
+	new := old
+	new.Version = 2
+	new.CompanyIds = []urn.URN{mustURN(t, "urn:uuid:51131FB5-42A2-4267-A402-0ECFEFAD1619"), mustURN(t, "urn:lei:1234")}
+	new.ProductIds = []urn.URN{mustURN(t, "urn:gtin:04712345060507")}
+	new.Pcf.PCfExcludingBiogenic = decimal.RequireFromString("1.5")
+	new.Pcf.FossilGhgEmissions = decimal.RequireFromString("1.3")
+	new.Pcf.CrossSectoralStandardsUsed = []Standard{ISO14067, GHGProtocol}
+	new.Extensions = []DataModelExtension{
+		{SpecVersion: "2.0.0", DataSchema: "https://example.com/a", Data: json.RawMessage(`{"id": "B", "weight": 10.0}`)},
+	}
+
+	diff := DiffProductFootprints(old, new)
+
+	assert.Equal(t, []string{
+		"version",
+		"productIds[urn:gtin:4712345060507]",
+		"productIds[urn:gtin:04712345060507]",
+		"pcf.fossilGhgEmissions",
+		"extensions[https://example.com/b]",
+		"extensions[https://example.com/a].data.id",
+	}, paths(diff))
+
+	assert.Equal(t, Changed, diff[0].Operation)
+	assert.Equal(t, Removed, diff[1].Operation)
+	assert.Equal(t, Added, diff[2].Operation)
+
+	assert.Equal(t, "~ pcf.fossilGhgEmissions: 1.2 -> 1.3", diff[3].String())
+
+	_, err := json.Marshal(diff)
+	assert.Nil(t, err)
+
+	assert.Empty(t, DiffProductFootprints(old, old))
+
+}
+
+func paths(diff Diff) []string {
+	var result []string
+	for _, entry := range diff {
+		result = append(result, entry.Path)
+	}
+	return result
+}
@@ -0,0 +1,238 @@
+package schema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	urn "github.com/leodido/go-urn"
+	"github.com/shopspring/decimal"
+)
+
+// Digest returns the SHA-256 digest of the content of the ProductFootprint,
+// excluding the metadata set by its host: id, version, created, updated,
+// precedingPfIds, status and statusComment.
+// The digest is computed on the canonical JSON form of the footprint (RFC 8785),
+// so the order of the members and the notation of the numbers do not matter,
+// and decimals are normalized, e.g. 1.50 and 1.5 have the same digest.
+// Footprints with the same digest report the same values.
+func (p ProductFootprint) Digest() (string, error) {
+	p.Id = uuid.Nil
+	p.Version = 0
+	p.Created = time.Time{}
+	p.Updated = time.Time{}
+	p.PrecedingPfIds = nil
+	p.Status = ""
+	p.StatusComment = ""
+
+	data, err := p.Canonical()
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(data)
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// FootprintGroup is a set of footprints of the same product, company and reference period
+type FootprintGroup struct {
+
+	// The distinct footprints of the group, in their original order
+	Footprints []ProductFootprint
+
+	// The footprints with the same id or digest as one of the distinct footprints
+	Duplicates []ProductFootprint
+
+	// Whether the group has several distinct footprints reporting different values
+	Conflict bool
+}
+
+// overlaps reports whether the footprints have a common identifier
+// once normalized, see Matcher
+func overlaps(a, b []urn.URN) bool {
+	keys := map[string]bool{}
+	for _, identifier := range a {
+		keys[identityKey(identifier)] = true
+	}
+	for _, identifier := range b {
+		if keys[identityKey(identifier)] {
+			return true
+		}
+	}
+	return false
+}
+
+// sameSubject reports whether the footprints are about the same product of the same company
+// for overlapping reference periods
+func sameSubject(a, b ProductFootprint) bool {
+	if a.Id != uuid.Nil && a.Id == b.Id {
+		return true
+	}
+	if !overlaps(a.ProductIds, b.ProductIds) {
+		return false
+	}
+	if (len(a.CompanyIds) > 0 || len(b.CompanyIds) > 0) && !overlaps(a.CompanyIds, b.CompanyIds) {
+		return false
+	}
+	return a.Pcf.ReferencePeriodStart.Before(b.Pcf.ReferencePeriodEnd) &&
+		b.Pcf.ReferencePeriodStart.Before(a.Pcf.ReferencePeriodEnd)
+}
+
+// DetectDuplicates groups the footprints collected from several sources by product identity,
+// company and overlapping reference periods. Within a group, the footprints with the same non-nil id
+// or the same digest are duplicates, and distinct footprints are in conflict.
+// Only the groups of more than one footprint are returned.
+func DetectDuplicates(footprints []ProductFootprint) ([]FootprintGroup, error) {
+	digests := make([]string, len(footprints))
+	for i, footprint := range footprints {
+		digest, err := footprint.Digest()
+		if err != nil {
+			return nil, err
+		}
+		digests[i] = digest
+	}
+
+	parents := make([]int, len(footprints))
+	for i := range parents {
+		parents[i] = i
+	}
+	var root func(int) int
+	root = func(i int) int {
+		if parents[i] != i {
+			parents[i] = root(parents[i])
+		}
+		return parents[i]
+	}
+
+	for i := range footprints {
+		for j := i + 1; j < len(footprints); j++ {
+			if sameSubject(footprints[i], footprints[j]) {
+				parents[root(j)] = root(i)
+			}
+		}
+	}
+
+	var roots []int
+	members := map[int][]int{}
+	for i := range footprints {
+		r := root(i)
+		if _, ok := members[r]; !ok {
+			roots = append(roots, r)
+		}
+		members[r] = append(members[r], i)
+	}
+
+	var groups []FootprintGroup
+	for _, r := range roots {
+		if len(members[r]) < 2 {
+			continue
+		}
+
+		var group FootprintGroup
+		var distinct []int
+		for _, i := range members[r] {
+			duplicate := false
+			for _, j := range distinct {
+				if (footprints[i].Id != uuid.Nil && footprints[i].Id == footprints[j].Id) || digests[i] == digests[j] {
+					duplicate = true
+					break
+				}
+			}
+			if duplicate {
+				group.Duplicates = append(group.Duplicates, footprints[i])
+			} else {
+				distinct = append(distinct, i)
+				group.Footprints = append(group.Footprints, footprints[i])
+			}
+		}
+		group.Conflict = len(group.Footprints) > 1
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// ResolutionStrategy compares two conflicting footprints, returning a positive number
+// if a is preferred, a negative number if b is preferred, and 0 if the strategy can not decide
+type ResolutionStrategy func(a, b ProductFootprint) int
+
+// LatestUpdated prefers the footprint updated or, if never updated, created last
+func LatestUpdated(a, b ProductFootprint) int {
+	return lastChange(a).Compare(lastChange(b))
+}
+
+func lastChange(p ProductFootprint) time.Time {
+	if p.Updated.IsZero() {
+		return p.Created
+	}
+	return p.Updated
+}
+
+// HighestVersion prefers the footprint with the highest version
+func HighestVersion(a, b ProductFootprint) int {
+	return int(a.Version) - int(b.Version)
+}
+
+// AssuredFirst prefers the footprint with assurance over the one without
+func AssuredFirst(a, b ProductFootprint) int {
+	switch {
+	case a.Pcf.Assurance.Assurance == b.Pcf.Assurance.Assurance:
+		return 0
+	case a.Pcf.Assurance.Assurance:
+		return 1
+	}
+	return -1
+}
+
+// BestDQR prefers the footprint with the lowest mean data quality rating,
+// footprints without data quality indicators come last
+func BestDQR(a, b ProductFootprint) int {
+	ratingA, okA := meanDQR(a.Pcf.Dqi)
+	ratingB, okB := meanDQR(b.Pcf.Dqi)
+	switch {
+	case okA && okB:
+		return ratingB.Cmp(ratingA)
+	case okA:
+		return 1
+	case okB:
+		return -1
+	}
+	return 0
+}
+
+// meanDQR returns the mean of the data quality ratings present, if any
+func meanDQR(d DataQualityIndicators) (decimal.Decimal, bool) {
+	var ratings []decimal.Decimal
+	for _, rating := range []decimal.Decimal{d.TechnologicalDQR, d.TemporalDQR, d.GeographicalDQR, d.CompletenessDQR, d.ReliabilityDQR} {
+		if !rating.IsZero() {
+			ratings = append(ratings, rating)
+		}
+	}
+	if len(ratings) == 0 {
+		return decimal.Zero, false
+	}
+	return decimal.Sum(ratings[0], ratings[1:]...).Div(decimal.NewFromInt(int64(len(ratings)))), true
+}
+
+// Resolve returns the footprint of the group preferred by the strategies,
+// each strategy deciding between the footprints the previous ones could not.
+// Without a decision, the first footprint is preferred.
+func (g FootprintGroup) Resolve(strategies ...ResolutionStrategy) ProductFootprint {
+	if len(g.Footprints) == 0 {
+		return ProductFootprint{}
+	}
+
+	best := g.Footprints[0]
+	for _, candidate := range g.Footprints[1:] {
+		for _, strategy := range strategies {
+			if preference := strategy(candidate, best); preference != 0 {
+				if preference > 0 {
+					best = candidate
+				}
+				break
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,133 @@
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	urn "github.com/leodido/go-urn"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectDuplicates(t *testing.T) {
+
+	footprint := func(version int32, gtin string, emissions int64, year int) ProductFootprint {
+		return ProductFootprint{
+			Id:         uuid.New(),
+			Version:    version,
+			Created:    time.Date(2024, 1, int(version), 0, 0, 0, 0, time.UTC),
+			CompanyIds: []urn.URN{mustURN(t, "urn:lei:5493001KJTIIGC8Y1R12")},
+			ProductIds: []urn.URN{mustURN(t, "urn:gtin:"+gtin)},
+			Pcf: CarbonFootprint{
+				PCfExcludingBiogenic: decimal.NewFromInt(emissions),
+				ReferencePeriodStart: time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
+				ReferencePeriodEnd:   time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		}
+	}
+
+	original := footprint(1, "4712345060507", 10, 2023)
+	copied := footprint(2, "4712345060507", 10, 2023)
+	resent := original
+	conflicting := footprint(3, "04712345060507", 12, 2023)
+	conflicting.Pcf.Assurance.Assurance = true
+	otherPeriod := footprint(4, "4712345060507", 11, 2022)
+	otherProduct := footprint(5, "96385074", 10, 2023)
+
+	groups, err := DetectDuplicates([]ProductFootprint{original, otherPeriod, copied, resent, conflicting, otherProduct})
+	assert.Nil(t, err)
+	assert.Len(t, groups, 1)
+
+	group := groups[0]
+	assert.Equal(t, []ProductFootprint{original, conflicting}, group.Footprints)
+	assert.Equal(t, []ProductFootprint{copied, resent}, group.Duplicates)
+	assert.True(t, group.Conflict)
+
+	assert.Equal(t, conflicting.Id, group.Resolve(HighestVersion).Id)
+	assert.Equal(t, conflicting.Id, group.Resolve(AssuredFirst, HighestVersion).Id)
+	assert.Equal(t, original.Id, group.Resolve(BestDQR).Id)
+
+	original.Pcf.Dqi.TechnologicalDQR = decimal.NewFromInt(1)
+	group.Footprints[0] = original
+	assert.Equal(t, original.Id, group.Resolve(BestDQR, LatestUpdated).Id)
+	assert.Equal(t, conflicting.Id, group.Resolve(LatestUpdated).Id)
+
+}
+
+func TestDetectDuplicatesWithoutId(t *testing.T) {
+
+	footprint := func(gtin string, emissions int64) ProductFootprint {
+		return ProductFootprint{
+			ProductIds: []urn.URN{mustURN(t, "urn:gtin:"+gtin)},
+			Pcf: CarbonFootprint{
+				PCfExcludingBiogenic: decimal.NewFromInt(emissions),
+				ReferencePeriodStart: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				ReferencePeriodEnd:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		}
+	}
+
+	// footprints without id are not grouped by their nil ids
+	groups, err := DetectDuplicates([]ProductFootprint{footprint("4712345060507", 1), footprint("96385074", 7)})
+	assert.Nil(t, err)
+	assert.Empty(t, groups)
+
+	// nor duplicates of each other
+	groups, err = DetectDuplicates([]ProductFootprint{footprint("4712345060507", 1), footprint("4712345060507", 7)})
+	assert.Nil(t, err)
+	assert.Len(t, groups, 1)
+	assert.Len(t, groups[0].Footprints, 2)
+	assert.Empty(t, groups[0].Duplicates)
+	assert.True(t, groups[0].Conflict)
+
+}
+
+func TestDigest(t *testing.T) {
+
+	footprint := func(emissions string, data string) ProductFootprint {
+		return ProductFootprint{
+			Id:  uuid.New(),
+			Pcf: CarbonFootprint{PCfExcludingBiogenic: decimal.RequireFromString(emissions)},
+			Extensions: []DataModelExtension{{
+				SpecVersion: ExtensionSpecVersion,
+				DataSchema:  "https://example.com/extension.json",
+				Data:        []byte(data),
+			}},
+		}
+	}
+
+	digest, err := footprint("1.5", `{"mass": 1.5, "unit": "kg"}`).Digest()
+	assert.Nil(t, err)
+
+	same, err := footprint("1.50", `{ "unit": "kg", "mass": 1.50 }`).Digest()
+	assert.Nil(t, err)
+	assert.Equal(t, digest, same)
+
+	different, err := footprint("1.5", `{"mass": 1.6, "unit": "kg"}`).Digest()
+	assert.Nil(t, err)
+	assert.NotEqual(t, digest, different)
+
+}
+
+func TestBestDQR(t *testing.T) {
+
+	a := ProductFootprint{Pcf: CarbonFootprint{Dqi: DataQualityIndicators{
+		TemporalDQR:     decimal.NewFromInt(2),
+		GeographicalDQR: decimal.NewFromInt(2),
+	}}}
+	b := ProductFootprint{Pcf: CarbonFootprint{Dqi: DataQualityIndicators{
+		TechnologicalDQR: decimal.NewFromInt(1),
+		TemporalDQR:      decimal.NewFromInt(3),
+		GeographicalDQR:  decimal.NewFromInt(3),
+	}}}
+
+	// the ratings missing are not averaged as 0: a has a mean of 2, b of 2.33
+	mean, ok := meanDQR(a.Pcf.Dqi)
+	assert.True(t, ok)
+	assert.True(t, mean.Equal(decimal.NewFromInt(2)))
+	assert.Positive(t, BestDQR(a, b))
+	assert.Negative(t, BestDQR(ProductFootprint{}, b))
+	assert.Zero(t, BestDQR(ProductFootprint{}, ProductFootprint{}))
+
+}
@@ -0,0 +1,186 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Error when the name or the version of an EmissionFactorDS is empty
+var ErrEmissionFactorDSRequired = errors.New("emission factor database name and version are required")
+
+// Error when the database of an EmissionFactorDS is not known
+var ErrEmissionFactorDSUnknown = errors.New("unknown emission factor database")
+
+// Error when the version of an EmissionFactorDS is not a version of its database
+var ErrEmissionFactorDSVersion = errors.New("unknown emission factor database version")
+
+// Error when the version of an EmissionFactorDS is older than the oldest version still maintained
+var ErrEmissionFactorDSOutdated = errors.New("outdated emission factor database version")
+
+// EmissionFactorDS references an emission factor database used to calculate a CarbonFootprint
+type EmissionFactorDS struct {
+
+	// The non-empty name of the database, e.g. ecoinvent
+	//
+	// Mandatory
+	Name string `json:"name"`
+
+	// The non-empty version of the database, e.g. 3.9.1
+	//
+	// Mandatory
+	Version string `json:"version"`
+}
+
+func (s EmissionFactorDS) String() string {
+	return s.Name + " " + s.Version
+}
+
+// EmissionFactorDatabase describes a well-known emission factor database and its versions
+type EmissionFactorDatabase struct {
+
+	// The canonical name of the database
+	Name string
+
+	// The other names the database is referenced by, matched regardless of case, spacing and punctuation
+	Aliases []string
+
+	// The format of the versions of the database
+	VersionFormat *regexp.Regexp
+
+	// The latest version known, newer versions are reported as unknown
+	Latest string
+
+	// The oldest version still maintained, older versions are reported as outdated
+	Oldest string
+}
+
+// EmissionFactorRegistry is a set of well-known emission factor databases.
+// The zero value is not usable, use NewEmissionFactorRegistry.
+type EmissionFactorRegistry struct {
+	databases map[string]EmissionFactorDatabase
+}
+
+// The emission factor databases known by NewEmissionFactorRegistry
+var emissionFactorDatabases = []EmissionFactorDatabase{
+	{
+		Name:          "ecoinvent",
+		VersionFormat: regexp.MustCompile(`^3\.[0-9]+(\.[0-9]+)?$`),
+		Latest:        "3.11",
+		Oldest:        "3.8",
+	},
+	{
+		Name:          "Sphera MLC",
+		Aliases:       []string{"GaBi", "Sphera", "Managed LCA Content", "GaBi Databases"},
+		VersionFormat: regexp.MustCompile(`^(CUP )?20[0-9]{2}(\.[0-9])?$`),
+		Latest:        "2025.1",
+		Oldest:        "2022.1",
+	},
+	{
+		Name:          "DEFRA",
+		Aliases:       []string{"UK Government GHG Conversion Factors", "DESNZ", "BEIS"},
+		VersionFormat: regexp.MustCompile(`^20[0-9]{2}$`),
+		Latest:        "2025",
+		Oldest:        "2022",
+	},
+	{
+		Name:          "EF",
+		Aliases:       []string{"Environmental Footprint", "EF database", "PEF"},
+		VersionFormat: regexp.MustCompile(`^[0-9]\.[0-9]$`),
+		Latest:        "3.1",
+		Oldest:        "3.0",
+	},
+	{
+		Name:          "GLEC",
+		Aliases:       []string{"GLEC Framework"},
+		VersionFormat: regexp.MustCompile(`^[0-9]\.[0-9]$`),
+		Latest:        "3.1",
+		Oldest:        "3.0",
+	},
+}
+
+// NewEmissionFactorRegistry returns a registry of the well-known databases:
+// ecoinvent, Sphera Managed LCA Content (formerly GaBi), DEFRA, the EF database and the GLEC Framework
+func NewEmissionFactorRegistry() *EmissionFactorRegistry {
+	r := &EmissionFactorRegistry{databases: map[string]EmissionFactorDatabase{}}
+	for _, database := range emissionFactorDatabases {
+		r.Add(database)
+	}
+	return r
+}
+
+// Add adds or replaces a database of the registry
+func (r *EmissionFactorRegistry) Add(database EmissionFactorDatabase) {
+	r.databases[foldEnum(database.Name)] = database
+	for _, alias := range database.Aliases {
+		r.databases[foldEnum(alias)] = database
+	}
+}
+
+// Lookup returns the database with the given name or alias
+func (r *EmissionFactorRegistry) Lookup(name string) (EmissionFactorDatabase, bool) {
+	database, ok := r.databases[foldEnum(name)]
+	return database, ok
+}
+
+// Check checks the name and version of the EmissionFactorDS are not empty, its database is known,
+// and its version is neither unknown nor outdated
+func (r *EmissionFactorRegistry) Check(source EmissionFactorDS) error {
+	switch {
+	case strings.TrimSpace(source.Name) == "":
+		return fmt.Errorf("%w: empty name", ErrEmissionFactorDSRequired)
+	case strings.TrimSpace(source.Version) == "":
+		return fmt.Errorf("%w: empty version of %s", ErrEmissionFactorDSRequired, source.Name)
+	}
+
+	database, ok := r.Lookup(source.Name)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrEmissionFactorDSUnknown, source.Name)
+	}
+
+	if database.VersionFormat != nil && !database.VersionFormat.MatchString(source.Version) {
+		return fmt.Errorf("%w: %s %q", ErrEmissionFactorDSVersion, database.Name, source.Version)
+	}
+	if database.Latest != "" && compareVersions(source.Version, database.Latest) > 0 {
+		return fmt.Errorf("%w: %s %s is newer than %s", ErrEmissionFactorDSVersion, database.Name, source.Version, database.Latest)
+	}
+	if database.Oldest != "" && compareVersions(source.Version, database.Oldest) < 0 {
+		return fmt.Errorf("%w: %s %s is older than %s", ErrEmissionFactorDSOutdated, database.Name, source.Version, database.Oldest)
+	}
+
+	return nil
+}
+
+var versionNumbers = regexp.MustCompile(`[0-9]+`)
+
+// compareVersions compares the numbers of two versions, e.g. 3.9.1 is before 3.10
+func compareVersions(a, b string) int {
+	x, y := versionNumbers.FindAllString(a, -1), versionNumbers.FindAllString(b, -1)
+	for i := 0; i < max(len(x), len(y)); i++ {
+		var m, n int
+		if i < len(x) {
+			m, _ = strconv.Atoi(x[i])
+		}
+		if i < len(y) {
+			n, _ = strconv.Atoi(y[i])
+		}
+		if m != n {
+			return m - n
+		}
+	}
+	return 0
+}
+
+// CheckEmissionFactorSources checks the secondaryEmissionFactorSources of the CarbonFootprint
+// against the registry, reporting every unknown database and unknown or outdated version
+func (c CarbonFootprint) CheckEmissionFactorSources(r *EmissionFactorRegistry) error {
+	var errs []error
+	for i, source := range c.SecondaryEmissionFactorSources {
+		if err := r.Check(source); err != nil {
+			errs = append(errs, fmt.Errorf("secondaryEmissionFactorSources[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
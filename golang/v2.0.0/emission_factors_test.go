@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmissionFactorSources(t *testing.T) {
+
+	var pcf CarbonFootprint
+	assert.Nil(t, json.Unmarshal([]byte(`{"secondaryEmissionFactorSources": [{"name": "Ecoinvent", "version": "1.2.3"}]}`), &pcf))
+	assert.Equal(t, []EmissionFactorDS{{Name: "Ecoinvent", Version: "1.2.3"}}, pcf.SecondaryEmissionFactorSources)
+
+	registry := NewEmissionFactorRegistry()
+	err := pcf.CheckEmissionFactorSources(registry)
+	assert.ErrorIs(t, err, ErrEmissionFactorDSVersion)
+	assert.EqualError(t, err, `secondaryEmissionFactorSources[0]: unknown emission factor database version: ecoinvent "1.2.3"`)
+
+	for source, expected := range map[EmissionFactorDS]error{
+		{Name: "ecoinvent", Version: "3.9.1"}:        nil,
+		{Name: "ecoinvent", Version: "3.10"}:         nil,
+		{Name: "ecoinvent", Version: "3.7.1"}:        ErrEmissionFactorDSOutdated,
+		{Name: "ecoinvent", Version: "3.12"}:         ErrEmissionFactorDSVersion,
+		{Name: "GaBi", Version: "2023.2"}:            nil,
+		{Name: "Sphera MLC", Version: "CUP 2021.1"}:  ErrEmissionFactorDSOutdated,
+		{Name: "DEFRA", Version: "2024"}:             nil,
+		{Name: "DEFRA", Version: "2024.1"}:           ErrEmissionFactorDSVersion,
+		{Name: "EF database", Version: "3.1"}:        nil,
+		{Name: "GLEC framework", Version: "2.0"}:     ErrEmissionFactorDSOutdated,
+		{Name: "In-house database", Version: "2024"}: ErrEmissionFactorDSUnknown,
+		{Name: "", Version: "3.9.1"}:                 ErrEmissionFactorDSRequired,
+		{Name: "ecoinvent", Version: " "}:            ErrEmissionFactorDSRequired,
+	} {
+		err := registry.Check(source)
+		if expected == nil {
+			assert.Nil(t, err, source.String())
+		} else {
+			assert.ErrorIs(t, err, expected, source.String())
+		}
+	}
+
+	registry.Add(EmissionFactorDatabase{Name: "In-house database"})
+	assert.Nil(t, registry.Check(EmissionFactorDS{Name: "in-house database", Version: "2024"}))
+
+}
+
+func TestEmissionFactorSourcesTestData(t *testing.T) {
+
+	data, err := os.ReadFile("../../test_data/product.json")
+	assert.Nil(t, err)
+
+	var response struct {
+		Data []ProductFootprint `json:"data"`
+	}
+	assert.Nil(t, json.Unmarshal(data, &response))
+	assert.Len(t, response.Data, 1)
+
+	pcf := response.Data[0].Pcf
+	assert.Equal(t, []EmissionFactorDS{{Name: "Ecoinvent", Version: "1.2.3"}}, pcf.SecondaryEmissionFactorSources)
+	assert.ErrorIs(t, pcf.CheckEmissionFactorSources(NewEmissionFactorRegistry()), ErrEmissionFactorDSVersion)
+
+}
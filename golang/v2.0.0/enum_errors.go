@@ -0,0 +1,153 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// EnumParseError is returned when a value is not valid for an enum.
+// It matches the ErrXXXParse sentinel of the enum with errors.Is.
+type EnumParseError struct {
+
+	// The name of the enum type, e.g. DeclaredUnit
+	Type string
+
+	// The offending value
+	Value string
+
+	// The valid values of the enum
+	Allowed []string
+
+	// If present, the valid value the offending value is likely a variant of,
+	// e.g. kilogram for Kilogram
+	Suggestion string
+
+	// If known, the JSON path of the value, e.g. pcf.declaredUnit
+	Path string
+
+	sentinel error
+}
+
+func newEnumParseError[T ~string](sentinel error, value string, allowed []T) *EnumParseError {
+	err := &EnumParseError{
+		Type:     reflect.TypeOf(allowed).Elem().Name(),
+		Value:    value,
+		Allowed:  make([]string, len(allowed)),
+		sentinel: sentinel,
+	}
+
+	for i, allowedValue := range allowed {
+		err.Allowed[i] = string(allowedValue)
+	}
+	err.Suggestion = suggest(value, err.Allowed)
+
+	return err
+}
+
+func (e *EnumParseError) Error() string {
+	var builder strings.Builder
+	if e.Path != "" {
+		builder.WriteString(e.Path)
+		builder.WriteString(": ")
+	}
+
+	fmt.Fprintf(&builder, "unsupported %s %q", e.Type, e.Value)
+
+	if e.Suggestion != "" {
+		fmt.Fprintf(&builder, ", did you mean %q?", e.Suggestion)
+	} else if len(e.Allowed) > 0 {
+		quoted := make([]string, len(e.Allowed))
+		for i, allowed := range e.Allowed {
+			quoted[i] = strconv.Quote(allowed)
+		}
+		fmt.Fprintf(&builder, ", allowed values: %s", strings.Join(quoted, ", "))
+	}
+
+	return builder.String()
+}
+
+func (e *EnumParseError) Unwrap() error {
+	return e.sentinel
+}
+
+// The maximum edit distance between a value and its suggestion
+const maxSuggestionDistance = 2
+
+// foldEnum removes the differences of case, spacing and punctuation between two values
+func foldEnum(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' || r == '_' || r == '.' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, value)
+}
+
+// suggest returns the allowed value closest to the value, or the empty string
+// if none of them are close enough
+func suggest(value string, allowed []string) string {
+	folded := foldEnum(value)
+	if folded == "" {
+		return ""
+	}
+
+	suggestion, best := "", maxSuggestionDistance+1
+	for _, candidate := range allowed {
+		distance := levenshtein(folded, foldEnum(candidate))
+		if distance < best && distance < len([]rune(folded)) {
+			suggestion, best = candidate, distance
+		}
+	}
+
+	return suggestion
+}
+
+func levenshtein(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	previous := make([]int, len(y)+1)
+	current := make([]int, len(y)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(x); i++ {
+		current[0] = i
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(y)]
+}
+
+// Unmarshal parses the JSON-encoded data into v like json.Unmarshal,
+// but reports the JSON path of the invalid enum values in the returned EnumParseError.
+func Unmarshal(data []byte, v any) error {
+	err := json.Unmarshal(data, v)
+
+	var enumErr *EnumParseError
+	if errors.As(err, &enumErr) && enumErr.Path == "" {
+		if document, decodeErr := decodeOrderedJSON(data); decodeErr == nil {
+			walkJSONStrings(document, reflect.TypeOf(v), "", func(path string, t reflect.Type, value string) (string, bool) {
+				if t.Name() == enumErr.Type && value == enumErr.Value {
+					enumErr.Path = path
+					return value, false
+				}
+				return value, true
+			})
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumParseErrorSuggestion(t *testing.T) {
+
+	tests := map[string]string{
+		"Kilogram":       "kilogram",
+		"cubic-meter":    "cubic meter",
+		"kilowatthour":   "kilowatt hour",
+		"literr":         "liter",
+		"pounds":         "",
+		"":               "",
+		"ton kilometers": "ton kilometer",
+	}
+
+	for value, suggestion := range tests {
+		_, err := ParseDeclaredUnit(value)
+		assert.ErrorIs(t, err, ErrDeclaredUnitParse)
+
+		var parseErr *EnumParseError
+		if assert.ErrorAs(t, err, &parseErr) {
+			assert.Equal(t, suggestion, parseErr.Suggestion, value)
+		}
+	}
+
+	_, err := ParseRegionOrSubregion("WesternEurope")
+	assert.EqualError(t, err, `unsupported RegionOrSubregion "WesternEurope", did you mean "Western Europe"?`)
+
+	_, err = ParseAssuranceLevel("none")
+	assert.EqualError(t, err, `unsupported AssuranceLevel "none", allowed values: "limited", "reasonable"`)
+
+}
+
+func TestUnmarshalEnumPath(t *testing.T) {
+
+	testData := `{
+		"pcf": {
+			"declaredUnit": "liter",
+			"crossSectoralStandardsUsed": [
+				"GHG Protocol Product standard",
+				"ISO 14067"
+			]
+		}
+	}`
+
+	var footprint ProductFootprint
+	err := Unmarshal([]byte(testData), &footprint)
+	assert.ErrorIs(t, err, ErrStandardParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "pcf.crossSectoralStandardsUsed[1]", parseErr.Path)
+	}
+
+	var inventory GasInventory
+	err = Unmarshal([]byte(`{"CO2": "1", "ch4 fossil": "1"}`), &inventory)
+	assert.EqualError(t, err, `ch4 fossil: unsupported GreenhouseGas "ch4 fossil", did you mean "CH4 fossil"?`)
+
+	// the path of the first invalid value in document order is reported, like encoding/json
+	var conversion struct {
+		Source DeclaredUnit `json:"source"`
+		Target DeclaredUnit `json:"target"`
+	}
+	for i := 0; i < 20; i++ {
+		err = Unmarshal([]byte(`{"target": "gallon", "source": "gallon"}`), &conversion)
+		if assert.ErrorAs(t, err, &parseErr) {
+			assert.Equal(t, "target", parseErr.Path)
+		}
+	}
+	err = Unmarshal([]byte(`{"CO2": "1", "N2O": "1", "ch4": "1", "CH4 biogenic": "1", "co2": "1"}`), &inventory)
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "ch4", parseErr.Path)
+	}
+
+}
@@ -0,0 +1,6 @@
+package schema
+
+// The string enums of the schema are generated from their definition in enums.json,
+// see internal/enumgen for the generated code and tests.
+//
+//go:generate go run ./internal/enumgen -definitions enums.json -output enums_gen.go -test enums_gen_test.go
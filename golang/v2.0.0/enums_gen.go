@@ -0,0 +1,1918 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+type Status string
+
+// Error parsing the Status
+var ErrStatusParse = errors.New("unsupported Status")
+
+var statusValues = map[string]Status{
+	"Active":     Active,
+	"Deprecated": Deprecated,
+}
+
+const (
+	// Active product
+	Active Status = "Active"
+
+	// Deprecated product
+	Deprecated Status = "Deprecated"
+)
+
+// ParseStatus returns the Status with the given value,
+// or an *EnumParseError matching ErrStatusParse
+func ParseStatus(value string) (Status, error) {
+	if parsed, ok := statusValues[value]; !ok {
+		return "", newEnumParseError(ErrStatusParse, value, Status("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of Status
+func (Status) Values() []Status {
+	return []Status{
+		Active,
+		Deprecated,
+	}
+}
+
+// IsValid reports whether the value is a valid Status
+func (u Status) IsValid() bool {
+	_, ok := statusValues[string(u)]
+	return ok
+}
+
+func (u Status) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u Status) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrStatusParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *Status) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseStatus(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u Status) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *Status) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+// liter: for unit liter
+// kilogram: for unit kilogram
+// cubic meter: for cubic meter
+// kilowatt hour: for kilowatt hour
+// megajoule: for megajoule
+// ton kilometer: for ton kilometer
+// square meter: for square meter
+type DeclaredUnit string
+
+// Error parsing the DeclaredUnit
+var ErrDeclaredUnitParse = errors.New("unsupported DeclaredUnit")
+
+var declaredUnitValues = map[string]DeclaredUnit{
+	"liter":         Liter,
+	"kilogram":      KiloGram,
+	"cubic meter":   CubicMeter,
+	"kilowatt hour": KiloWattHour,
+	"megajoule":     MegaJoule,
+	"ton kilometer": TonKilometer,
+	"square meter":  SquareMeter,
+}
+
+const (
+	// for unit liter
+	Liter DeclaredUnit = "liter"
+
+	// for unit kilogram
+	KiloGram DeclaredUnit = "kilogram"
+
+	// for cubic meter
+	CubicMeter DeclaredUnit = "cubic meter"
+
+	// for kilowatt hour
+	KiloWattHour DeclaredUnit = "kilowatt hour"
+
+	// for megajoule
+	MegaJoule DeclaredUnit = "megajoule"
+
+	// for ton kilometer
+	TonKilometer DeclaredUnit = "ton kilometer"
+
+	// for square meter
+	SquareMeter DeclaredUnit = "square meter"
+)
+
+// ParseDeclaredUnit returns the DeclaredUnit with the given value,
+// or an *EnumParseError matching ErrDeclaredUnitParse
+func ParseDeclaredUnit(value string) (DeclaredUnit, error) {
+	if parsed, ok := declaredUnitValues[value]; !ok {
+		return "", newEnumParseError(ErrDeclaredUnitParse, value, DeclaredUnit("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of DeclaredUnit
+func (DeclaredUnit) Values() []DeclaredUnit {
+	return []DeclaredUnit{
+		Liter,
+		KiloGram,
+		CubicMeter,
+		KiloWattHour,
+		MegaJoule,
+		TonKilometer,
+		SquareMeter,
+	}
+}
+
+// IsValid reports whether the value is a valid DeclaredUnit
+func (u DeclaredUnit) IsValid() bool {
+	_, ok := declaredUnitValues[string(u)]
+	return ok
+}
+
+func (u DeclaredUnit) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u DeclaredUnit) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrDeclaredUnitParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *DeclaredUnit) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseDeclaredUnit(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u DeclaredUnit) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *DeclaredUnit) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type CharacterizationFactor string
+
+// Error parsing the CharacterizationFactor
+var ErrCharacterizationFactorParse = errors.New("unsupported CharacterizationFactor")
+
+var characterizationFactorValues = map[string]CharacterizationFactor{
+	"AR6": AR6,
+	"AR5": AR5,
+}
+
+const (
+	// for the Sixth Assessment Report of the Intergovernmental Panel on Climate Change (IPCC)
+	AR6 CharacterizationFactor = "AR6"
+
+	// for the Fifth Assessment Report of the IPCC.
+	AR5 CharacterizationFactor = "AR5"
+)
+
+// ParseCharacterizationFactor returns the CharacterizationFactor with the given value,
+// or an *EnumParseError matching ErrCharacterizationFactorParse
+func ParseCharacterizationFactor(value string) (CharacterizationFactor, error) {
+	if parsed, ok := characterizationFactorValues[value]; !ok {
+		return "", newEnumParseError(ErrCharacterizationFactorParse, value, CharacterizationFactor("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of CharacterizationFactor
+func (CharacterizationFactor) Values() []CharacterizationFactor {
+	return []CharacterizationFactor{
+		AR6,
+		AR5,
+	}
+}
+
+// IsValid reports whether the value is a valid CharacterizationFactor
+func (u CharacterizationFactor) IsValid() bool {
+	_, ok := characterizationFactorValues[string(u)]
+	return ok
+}
+
+func (u CharacterizationFactor) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u CharacterizationFactor) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrCharacterizationFactorParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *CharacterizationFactor) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseCharacterizationFactor(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u CharacterizationFactor) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *CharacterizationFactor) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type Standard string
+
+// Error parsing the Standard
+var ErrStandardParse = errors.New("unsupported Standard")
+
+var standardValues = map[string]Standard{
+	"GHG Protocol Product standard": GHGProtocol,
+	"ISO Standard 14067":            ISO14067,
+	"ISO Standard 14044":            ISO14044,
+}
+
+const (
+	// GHG Protocol Product standard
+	GHGProtocol Standard = "GHG Protocol Product standard"
+
+	// ISO Standard 14067
+	ISO14067 Standard = "ISO Standard 14067"
+
+	// ISO Standard 14044
+	ISO14044 Standard = "ISO Standard 14044"
+)
+
+// ParseStandard returns the Standard with the given value,
+// or an *EnumParseError matching ErrStandardParse
+func ParseStandard(value string) (Standard, error) {
+	if parsed, ok := standardValues[value]; !ok {
+		return "", newEnumParseError(ErrStandardParse, value, Standard("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of Standard
+func (Standard) Values() []Standard {
+	return []Standard{
+		GHGProtocol,
+		ISO14067,
+		ISO14044,
+	}
+}
+
+// IsValid reports whether the value is a valid Standard
+func (u Standard) IsValid() bool {
+	_, ok := standardValues[string(u)]
+	return ok
+}
+
+func (u Standard) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u Standard) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrStandardParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *Standard) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseStandard(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u Standard) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *Standard) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type PCROperator string
+
+// Error parsing the PCROperator
+var ErrPCROperatorParse = errors.New("unsupported PCROperator")
+
+var pcrOperatorValues = map[string]PCROperator{
+	"PEF":               PEF,
+	"EPD International": EPD,
+	"Other":             Other,
+}
+
+const (
+	// for EU / PEF Methodology PCRs
+	// https://wayback.archive-it.org/12090/20230313054137/https://ec.europa.eu/environment/archives/eussd/pdf/footprint/PEF%20methodology%20final%20draft.pdf
+	PEF PCROperator = "PEF"
+
+	// for PCRs authored or published by EPD International
+	// https://www.environdec.com/home
+	EPD PCROperator = "EPD International"
+
+	// for a PCR not published by the operators mentioned above
+	Other PCROperator = "Other"
+)
+
+// ParsePCROperator returns the PCROperator with the given value,
+// or an *EnumParseError matching ErrPCROperatorParse
+func ParsePCROperator(value string) (PCROperator, error) {
+	if parsed, ok := pcrOperatorValues[value]; !ok {
+		return "", newEnumParseError(ErrPCROperatorParse, value, PCROperator("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of PCROperator
+func (PCROperator) Values() []PCROperator {
+	return []PCROperator{
+		PEF,
+		EPD,
+		Other,
+	}
+}
+
+// IsValid reports whether the value is a valid PCROperator
+func (u PCROperator) IsValid() bool {
+	_, ok := pcrOperatorValues[string(u)]
+	return ok
+}
+
+func (u PCROperator) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u PCROperator) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrPCROperatorParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *PCROperator) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParsePCROperator(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u PCROperator) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *PCROperator) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type AccountingMethodology string
+
+// Error parsing the AccountingMethodology
+var ErrAccountingMethodologyParse = errors.New("unsupported AccountingMethodology")
+
+var accountingMethodologyValues = map[string]AccountingMethodology{
+	"PEF":     PEFAccounting,
+	"ISO":     ISOAccounting,
+	"GHGP":    GHGPAccounting,
+	"Quantis": QuantisAccounting,
+}
+
+const (
+	// for the EU Product Environmental Footprint Guide
+	PEFAccounting AccountingMethodology = "PEF"
+
+	// For the ISO 14067 standard
+	ISOAccounting AccountingMethodology = "ISO"
+
+	// For the Greenhouse Gas Protocol (GHGP) Land sector and Removals Guidance
+	GHGPAccounting AccountingMethodology = "GHGP"
+
+	// For the Quantis Accounting for Natural Climate Solutions Guidance
+	QuantisAccounting AccountingMethodology = "Quantis"
+)
+
+// ParseAccountingMethodology returns the AccountingMethodology with the given value,
+// or an *EnumParseError matching ErrAccountingMethodologyParse
+func ParseAccountingMethodology(value string) (AccountingMethodology, error) {
+	if parsed, ok := accountingMethodologyValues[value]; !ok {
+		return "", newEnumParseError(ErrAccountingMethodologyParse, value, AccountingMethodology("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of AccountingMethodology
+func (AccountingMethodology) Values() []AccountingMethodology {
+	return []AccountingMethodology{
+		PEFAccounting,
+		ISOAccounting,
+		GHGPAccounting,
+		QuantisAccounting,
+	}
+}
+
+// IsValid reports whether the value is a valid AccountingMethodology
+func (u AccountingMethodology) IsValid() bool {
+	_, ok := accountingMethodologyValues[string(u)]
+	return ok
+}
+
+func (u AccountingMethodology) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u AccountingMethodology) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrAccountingMethodologyParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *AccountingMethodology) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseAccountingMethodology(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u AccountingMethodology) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *AccountingMethodology) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type Coverage string
+
+// Error parsing the Coverage
+var ErrCoverageParse = errors.New("unsupported Coverage")
+
+var coverageValues = map[string]Coverage{
+	"corporate level": Corporate,
+	"product line":    ProductLine,
+	"PCF system":      PCF,
+	"product level":   Product,
+}
+
+const (
+	// Corporate level of granularity of the emissions data assured
+	Corporate Coverage = "corporate level"
+
+	// Product line level of granularity of the emissions data assured
+	ProductLine Coverage = "product line"
+
+	// PCF system level of granularity of the emissions data assured
+	PCF Coverage = "PCF system"
+
+	// Product level of granularity of the emissions data assured
+	Product Coverage = "product level"
+)
+
+// ParseCoverage returns the Coverage with the given value,
+// or an *EnumParseError matching ErrCoverageParse
+func ParseCoverage(value string) (Coverage, error) {
+	if parsed, ok := coverageValues[value]; !ok {
+		return "", newEnumParseError(ErrCoverageParse, value, Coverage("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of Coverage
+func (Coverage) Values() []Coverage {
+	return []Coverage{
+		Corporate,
+		ProductLine,
+		PCF,
+		Product,
+	}
+}
+
+// IsValid reports whether the value is a valid Coverage
+func (u Coverage) IsValid() bool {
+	_, ok := coverageValues[string(u)]
+	return ok
+}
+
+func (u Coverage) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u Coverage) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrCoverageParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *Coverage) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseCoverage(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u Coverage) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *Coverage) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type AssuranceLevel string
+
+// Error parsing the AssuranceLevel
+var ErrAssuranceLevelParse = errors.New("unsupported AssuranceLevel")
+
+var assuranceLevelValues = map[string]AssuranceLevel{
+	"limited":    Limited,
+	"reasonable": Reasonable,
+}
+
+const (
+	// for limited assurance
+	Limited AssuranceLevel = "limited"
+
+	// for reasonable assurance
+	Reasonable AssuranceLevel = "reasonable"
+)
+
+// ParseAssuranceLevel returns the AssuranceLevel with the given value,
+// or an *EnumParseError matching ErrAssuranceLevelParse
+func ParseAssuranceLevel(value string) (AssuranceLevel, error) {
+	if parsed, ok := assuranceLevelValues[value]; !ok {
+		return "", newEnumParseError(ErrAssuranceLevelParse, value, AssuranceLevel("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of AssuranceLevel
+func (AssuranceLevel) Values() []AssuranceLevel {
+	return []AssuranceLevel{
+		Limited,
+		Reasonable,
+	}
+}
+
+// IsValid reports whether the value is a valid AssuranceLevel
+func (u AssuranceLevel) IsValid() bool {
+	_, ok := assuranceLevelValues[string(u)]
+	return ok
+}
+
+func (u AssuranceLevel) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u AssuranceLevel) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrAssuranceLevelParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *AssuranceLevel) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseAssuranceLevel(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u AssuranceLevel) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *AssuranceLevel) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type AssuranceBoundary string
+
+// Error parsing the AssuranceBoundary
+var ErrAssuranceBoundaryParse = errors.New("unsupported AssuranceBoundary")
+
+var assuranceBoundaryValues = map[string]AssuranceBoundary{
+	"Gate-to-Gate":   GateToGate,
+	"Cradle-to-Gate": CradleToGate,
+}
+
+const (
+	// Gate-to-Gate
+	GateToGate AssuranceBoundary = "Gate-to-Gate"
+
+	// Cradle-to-Gate
+	CradleToGate AssuranceBoundary = "Cradle-to-Gate"
+)
+
+// ParseAssuranceBoundary returns the AssuranceBoundary with the given value,
+// or an *EnumParseError matching ErrAssuranceBoundaryParse
+func ParseAssuranceBoundary(value string) (AssuranceBoundary, error) {
+	if parsed, ok := assuranceBoundaryValues[value]; !ok {
+		return "", newEnumParseError(ErrAssuranceBoundaryParse, value, AssuranceBoundary("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of AssuranceBoundary
+func (AssuranceBoundary) Values() []AssuranceBoundary {
+	return []AssuranceBoundary{
+		GateToGate,
+		CradleToGate,
+	}
+}
+
+// IsValid reports whether the value is a valid AssuranceBoundary
+func (u AssuranceBoundary) IsValid() bool {
+	_, ok := assuranceBoundaryValues[string(u)]
+	return ok
+}
+
+func (u AssuranceBoundary) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u AssuranceBoundary) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrAssuranceBoundaryParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *AssuranceBoundary) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseAssuranceBoundary(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u AssuranceBoundary) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *AssuranceBoundary) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type RegionOrSubregion string
+
+// Error parsing the RegionOrSubregion
+var ErrRegionOrSubregionParse = errors.New("unsupported RegionOrSubregion")
+
+var regionOrSubregionValues = map[string]RegionOrSubregion{
+	"Africa":                          Africa,
+	"Americas":                        Americas,
+	"Asia":                            Asia,
+	"Europe":                          Europe,
+	"Oceania":                         Oceania,
+	"Australia and New Zealand":       AustraliaAndNewZealand,
+	"Central Asia":                    CentralAsia,
+	"Eastern Asia":                    EasternAsia,
+	"Eastern Europe":                  EasternEurope,
+	"Latin America and the Caribbean": LatinAmericaAndCaribbean,
+	"Melanesia":                       Melanesia,
+	"Micronesia":                      Micronesia,
+	"Northern Africa":                 NorthernAfrica,
+	"Northern America":                NorthernAmerica,
+	"Northern Europe":                 NorthernEurope,
+	"Polynesia":                       Polynesia,
+	"South-eastern Asia":              SouthEasternAsia,
+	"Southern Asia":                   SouthernAsia,
+	"Southern Europe":                 SouthernEurope,
+	"Sub-Saharan Africa":              SubSaharanAfrica,
+	"Western Asia":                    WesternAsia,
+	"Western Europe":                  WesternEurope,
+}
+
+const (
+	// for the UN geographic region Africa
+	Africa RegionOrSubregion = "Africa"
+
+	// for the UN geographic region Americas
+	Americas RegionOrSubregion = "Americas"
+
+	// for the UN geographic region Asia
+	Asia RegionOrSubregion = "Asia"
+
+	// for the UN geographic region Europe
+	Europe RegionOrSubregion = "Europe"
+
+	// for the UN geographic region Oceania
+	Oceania RegionOrSubregion = "Oceania"
+
+	// for the UN geographic subregion Australia and New Zealand
+	AustraliaAndNewZealand RegionOrSubregion = "Australia and New Zealand"
+
+	// for the UN geographic subregion Central Asia
+	CentralAsia RegionOrSubregion = "Central Asia"
+
+	// for the UN geographic subregion Eastern Asia
+	EasternAsia RegionOrSubregion = "Eastern Asia"
+
+	// for the UN geographic subregion Eastern Europe
+	EasternEurope RegionOrSubregion = "Eastern Europe"
+
+	// for the UN geographic subregion Latin America and the Caribbean
+	LatinAmericaAndCaribbean RegionOrSubregion = "Latin America and the Caribbean"
+
+	// for the UN geographic subregion Melanesia
+	Melanesia RegionOrSubregion = "Melanesia"
+
+	// for the UN geographic subregion Micronesia
+	Micronesia RegionOrSubregion = "Micronesia"
+
+	// for the UN geographic subregion Northern Africa
+	NorthernAfrica RegionOrSubregion = "Northern Africa"
+
+	// for the UN geographic subregion Northern America
+	NorthernAmerica RegionOrSubregion = "Northern America"
+
+	// for the UN geographic subregion Northern Europe
+	NorthernEurope RegionOrSubregion = "Northern Europe"
+
+	// for the UN geographic subregion Polynesia
+	Polynesia RegionOrSubregion = "Polynesia"
+
+	// for the UN geographic subregion South-eastern Asia
+	SouthEasternAsia RegionOrSubregion = "South-eastern Asia"
+
+	// for the UN geographic subregion Southern Asia
+	SouthernAsia RegionOrSubregion = "Southern Asia"
+
+	// for the UN geographic subregion Southern Europe
+	SouthernEurope RegionOrSubregion = "Southern Europe"
+
+	// for the UN geographic subregion Sub-Saharan Africa
+	SubSaharanAfrica RegionOrSubregion = "Sub-Saharan Africa"
+
+	// for the UN geographic subregion Western Asia
+	WesternAsia RegionOrSubregion = "Western Asia"
+
+	// for the UN geographic subregion Western Europe
+	WesternEurope RegionOrSubregion = "Western Europe"
+)
+
+// ParseRegionOrSubregion returns the RegionOrSubregion with the given value,
+// or an *EnumParseError matching ErrRegionOrSubregionParse
+func ParseRegionOrSubregion(value string) (RegionOrSubregion, error) {
+	if parsed, ok := regionOrSubregionValues[value]; !ok {
+		return "", newEnumParseError(ErrRegionOrSubregionParse, value, RegionOrSubregion("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of RegionOrSubregion
+func (RegionOrSubregion) Values() []RegionOrSubregion {
+	return []RegionOrSubregion{
+		Africa,
+		Americas,
+		Asia,
+		Europe,
+		Oceania,
+		AustraliaAndNewZealand,
+		CentralAsia,
+		EasternAsia,
+		EasternEurope,
+		LatinAmericaAndCaribbean,
+		Melanesia,
+		Micronesia,
+		NorthernAfrica,
+		NorthernAmerica,
+		NorthernEurope,
+		Polynesia,
+		SouthEasternAsia,
+		SouthernAsia,
+		SouthernEurope,
+		SubSaharanAfrica,
+		WesternAsia,
+		WesternEurope,
+	}
+}
+
+// IsValid reports whether the value is a valid RegionOrSubregion
+func (u RegionOrSubregion) IsValid() bool {
+	_, ok := regionOrSubregionValues[string(u)]
+	return ok
+}
+
+func (u RegionOrSubregion) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u RegionOrSubregion) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrRegionOrSubregionParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *RegionOrSubregion) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseRegionOrSubregion(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u RegionOrSubregion) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *RegionOrSubregion) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type GreenhouseGas string
+
+// Error parsing the GreenhouseGas
+var ErrGreenhouseGasParse = errors.New("unsupported GreenhouseGas")
+
+var greenhouseGasValues = map[string]GreenhouseGas{
+	"CO2":          CO2,
+	"CH4 fossil":   CH4Fossil,
+	"CH4 biogenic": CH4Biogenic,
+	"N2O":          N2O,
+	"SF6":          SF6,
+	"NF3":          NF3,
+	"HFC-23":       HFC23,
+	"HFC-32":       HFC32,
+	"HFC-125":      HFC125,
+	"HFC-134a":     HFC134a,
+	"HFC-143a":     HFC143a,
+	"HFC-152a":     HFC152a,
+	"HFC-227ea":    HFC227ea,
+	"HFC-245fa":    HFC245fa,
+	"PFC-14":       PFC14,
+	"PFC-116":      PFC116,
+	"PFC-218":      PFC218,
+	"PFC-318":      PFC318,
+}
+
+const (
+	// carbon dioxide
+	CO2 GreenhouseGas = "CO2"
+
+	// methane from fossil sources
+	CH4Fossil GreenhouseGas = "CH4 fossil"
+
+	// methane from biogenic (non-fossil) sources
+	CH4Biogenic GreenhouseGas = "CH4 biogenic"
+
+	// nitrous oxide
+	N2O GreenhouseGas = "N2O"
+
+	// sulfur hexafluoride
+	SF6 GreenhouseGas = "SF6"
+
+	// nitrogen trifluoride
+	NF3 GreenhouseGas = "NF3"
+
+	// trifluoromethane (CHF3)
+	HFC23 GreenhouseGas = "HFC-23"
+
+	// difluoromethane (CH2F2)
+	HFC32 GreenhouseGas = "HFC-32"
+
+	// pentafluoroethane (CHF2CF3)
+	HFC125 GreenhouseGas = "HFC-125"
+
+	// 1,1,1,2-tetrafluoroethane (CH2FCF3)
+	HFC134a GreenhouseGas = "HFC-134a"
+
+	// 1,1,1-trifluoroethane (CH3CF3)
+	HFC143a GreenhouseGas = "HFC-143a"
+
+	// 1,1-difluoroethane (CH3CHF2)
+	HFC152a GreenhouseGas = "HFC-152a"
+
+	// 1,1,1,2,3,3,3-heptafluoropropane (CF3CHFCF3)
+	HFC227ea GreenhouseGas = "HFC-227ea"
+
+	// 1,1,1,3,3-pentafluoropropane (CHF2CH2CF3)
+	HFC245fa GreenhouseGas = "HFC-245fa"
+
+	// tetrafluoromethane (CF4)
+	PFC14 GreenhouseGas = "PFC-14"
+
+	// hexafluoroethane (C2F6)
+	PFC116 GreenhouseGas = "PFC-116"
+
+	// octafluoropropane (C3F8)
+	PFC218 GreenhouseGas = "PFC-218"
+
+	// octafluorocyclobutane (c-C4F8)
+	PFC318 GreenhouseGas = "PFC-318"
+)
+
+// ParseGreenhouseGas returns the GreenhouseGas with the given value,
+// or an *EnumParseError matching ErrGreenhouseGasParse
+func ParseGreenhouseGas(value string) (GreenhouseGas, error) {
+	if parsed, ok := greenhouseGasValues[value]; !ok {
+		return "", newEnumParseError(ErrGreenhouseGasParse, value, GreenhouseGas("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of GreenhouseGas
+func (GreenhouseGas) Values() []GreenhouseGas {
+	return []GreenhouseGas{
+		CO2,
+		CH4Fossil,
+		CH4Biogenic,
+		N2O,
+		SF6,
+		NF3,
+		HFC23,
+		HFC32,
+		HFC125,
+		HFC134a,
+		HFC143a,
+		HFC152a,
+		HFC227ea,
+		HFC245fa,
+		PFC14,
+		PFC116,
+		PFC218,
+		PFC318,
+	}
+}
+
+// IsValid reports whether the value is a valid GreenhouseGas
+func (u GreenhouseGas) IsValid() bool {
+	_, ok := greenhouseGasValues[string(u)]
+	return ok
+}
+
+func (u GreenhouseGas) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u GreenhouseGas) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrGreenhouseGasParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *GreenhouseGas) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseGreenhouseGas(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u GreenhouseGas) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *GreenhouseGas) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type ShipmentType string
+
+// Error parsing the ShipmentType
+var ErrShipmentTypeParse = errors.New("unsupported ShipmentType")
+
+var shipmentTypeValues = map[string]ShipmentType{
+	"PICKUP":   PickupShipment,
+	"DELIVERY": DeliveryShipment,
+}
+
+const (
+	// for a shipment picked up from the shipper
+	PickupShipment ShipmentType = "PICKUP"
+
+	// for a shipment delivered to the consignee
+	DeliveryShipment ShipmentType = "DELIVERY"
+)
+
+// ParseShipmentType returns the ShipmentType with the given value,
+// or an *EnumParseError matching ErrShipmentTypeParse
+func ParseShipmentType(value string) (ShipmentType, error) {
+	if parsed, ok := shipmentTypeValues[value]; !ok {
+		return "", newEnumParseError(ErrShipmentTypeParse, value, ShipmentType("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of ShipmentType
+func (ShipmentType) Values() []ShipmentType {
+	return []ShipmentType{
+		PickupShipment,
+		DeliveryShipment,
+	}
+}
+
+// IsValid reports whether the value is a valid ShipmentType
+func (u ShipmentType) IsValid() bool {
+	_, ok := shipmentTypeValues[string(u)]
+	return ok
+}
+
+func (u ShipmentType) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u ShipmentType) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrShipmentTypeParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *ShipmentType) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseShipmentType(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u ShipmentType) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *ShipmentType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type TransportMode string
+
+// Error parsing the TransportMode
+var ErrTransportModeParse = errors.New("unsupported TransportMode")
+
+var transportModeValues = map[string]TransportMode{
+	"Road":           RoadTransport,
+	"Rail":           RailTransport,
+	"Air":            AirTransport,
+	"Sea":            SeaTransport,
+	"InlandWaterway": InlandWaterwayTransport,
+}
+
+const (
+	// for road transport
+	RoadTransport TransportMode = "Road"
+
+	// for rail transport
+	RailTransport TransportMode = "Rail"
+
+	// for air transport
+	AirTransport TransportMode = "Air"
+
+	// for sea transport
+	SeaTransport TransportMode = "Sea"
+
+	// for inland waterway transport
+	InlandWaterwayTransport TransportMode = "InlandWaterway"
+)
+
+// ParseTransportMode returns the TransportMode with the given value,
+// or an *EnumParseError matching ErrTransportModeParse
+func ParseTransportMode(value string) (TransportMode, error) {
+	if parsed, ok := transportModeValues[value]; !ok {
+		return "", newEnumParseError(ErrTransportModeParse, value, TransportMode("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of TransportMode
+func (TransportMode) Values() []TransportMode {
+	return []TransportMode{
+		RoadTransport,
+		RailTransport,
+		AirTransport,
+		SeaTransport,
+		InlandWaterwayTransport,
+	}
+}
+
+// IsValid reports whether the value is a valid TransportMode
+func (u TransportMode) IsValid() bool {
+	_, ok := transportModeValues[string(u)]
+	return ok
+}
+
+func (u TransportMode) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u TransportMode) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrTransportModeParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *TransportMode) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseTransportMode(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u TransportMode) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *TransportMode) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type HubType string
+
+// Error parsing the HubType
+var ErrHubTypeParse = errors.New("unsupported HubType")
+
+var hubTypeValues = map[string]HubType{
+	"Transshipment":             TransshipmentHub,
+	"StorageAndTransshipment":   StorageAndTransshipmentHub,
+	"Warehouse":                 WarehouseHub,
+	"LiquidBulkTerminal":        LiquidBulkTerminalHub,
+	"MaritimeContainerterminal": MaritimeContainerTerminalHub,
+}
+
+const (
+	// for a transshipment hub
+	TransshipmentHub HubType = "Transshipment"
+
+	// for a storage and transshipment hub
+	StorageAndTransshipmentHub HubType = "StorageAndTransshipment"
+
+	// for a warehouse
+	WarehouseHub HubType = "Warehouse"
+
+	// for a liquid bulk terminal
+	LiquidBulkTerminalHub HubType = "LiquidBulkTerminal"
+
+	// for a maritime container terminal
+	MaritimeContainerTerminalHub HubType = "MaritimeContainerterminal"
+)
+
+// ParseHubType returns the HubType with the given value,
+// or an *EnumParseError matching ErrHubTypeParse
+func ParseHubType(value string) (HubType, error) {
+	if parsed, ok := hubTypeValues[value]; !ok {
+		return "", newEnumParseError(ErrHubTypeParse, value, HubType("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of HubType
+func (HubType) Values() []HubType {
+	return []HubType{
+		TransshipmentHub,
+		StorageAndTransshipmentHub,
+		WarehouseHub,
+		LiquidBulkTerminalHub,
+		MaritimeContainerTerminalHub,
+	}
+}
+
+// IsValid reports whether the value is a valid HubType
+func (u HubType) IsValid() bool {
+	_, ok := hubTypeValues[string(u)]
+	return ok
+}
+
+func (u HubType) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u HubType) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrHubTypeParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *HubType) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseHubType(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u HubType) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *HubType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type TemperatureControl string
+
+// Error parsing the TemperatureControl
+var ErrTemperatureControlParse = errors.New("unsupported TemperatureControl")
+
+var temperatureControlValues = map[string]TemperatureControl{
+	"ambient":      AmbientTemperature,
+	"refrigerated": RefrigeratedTemperature,
+	"mixed":        MixedTemperature,
+}
+
+const (
+	// for ambient temperature
+	AmbientTemperature TemperatureControl = "ambient"
+
+	// for refrigerated or frozen goods
+	RefrigeratedTemperature TemperatureControl = "refrigerated"
+
+	// for both ambient and refrigerated goods
+	MixedTemperature TemperatureControl = "mixed"
+)
+
+// ParseTemperatureControl returns the TemperatureControl with the given value,
+// or an *EnumParseError matching ErrTemperatureControlParse
+func ParseTemperatureControl(value string) (TemperatureControl, error) {
+	if parsed, ok := temperatureControlValues[value]; !ok {
+		return "", newEnumParseError(ErrTemperatureControlParse, value, TemperatureControl("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of TemperatureControl
+func (TemperatureControl) Values() []TemperatureControl {
+	return []TemperatureControl{
+		AmbientTemperature,
+		RefrigeratedTemperature,
+		MixedTemperature,
+	}
+}
+
+// IsValid reports whether the value is a valid TemperatureControl
+func (u TemperatureControl) IsValid() bool {
+	_, ok := temperatureControlValues[string(u)]
+	return ok
+}
+
+func (u TemperatureControl) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u TemperatureControl) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrTemperatureControlParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *TemperatureControl) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseTemperatureControl(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u TemperatureControl) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *TemperatureControl) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type EnergyCarrierType string
+
+// Error parsing the EnergyCarrierType
+var ErrEnergyCarrierTypeParse = errors.New("unsupported EnergyCarrierType")
+
+var energyCarrierTypeValues = map[string]EnergyCarrierType{
+	"Diesel":        DieselEnergy,
+	"HVO":           HVOEnergy,
+	"Petrol":        PetrolEnergy,
+	"CNG":           CNGEnergy,
+	"LNG":           LNGEnergy,
+	"LPG":           LPGEnergy,
+	"HFO":           HFOEnergy,
+	"MGO":           MGOEnergy,
+	"Aviation fuel": AviationFuelEnergy,
+	"Hydrogen":      HydrogenEnergy,
+	"Methanol":      MethanolEnergy,
+	"Electric":      ElectricEnergy,
+}
+
+const (
+	// for diesel
+	DieselEnergy EnergyCarrierType = "Diesel"
+
+	// for hydrotreated vegetable oil
+	HVOEnergy EnergyCarrierType = "HVO"
+
+	// for petrol
+	PetrolEnergy EnergyCarrierType = "Petrol"
+
+	// for compressed natural gas
+	CNGEnergy EnergyCarrierType = "CNG"
+
+	// for liquefied natural gas
+	LNGEnergy EnergyCarrierType = "LNG"
+
+	// for liquefied petroleum gas
+	LPGEnergy EnergyCarrierType = "LPG"
+
+	// for heavy fuel oil
+	HFOEnergy EnergyCarrierType = "HFO"
+
+	// for marine gas oil
+	MGOEnergy EnergyCarrierType = "MGO"
+
+	// for aviation fuel
+	AviationFuelEnergy EnergyCarrierType = "Aviation fuel"
+
+	// for hydrogen
+	HydrogenEnergy EnergyCarrierType = "Hydrogen"
+
+	// for methanol
+	MethanolEnergy EnergyCarrierType = "Methanol"
+
+	// for electricity
+	ElectricEnergy EnergyCarrierType = "Electric"
+)
+
+// ParseEnergyCarrierType returns the EnergyCarrierType with the given value,
+// or an *EnumParseError matching ErrEnergyCarrierTypeParse
+func ParseEnergyCarrierType(value string) (EnergyCarrierType, error) {
+	if parsed, ok := energyCarrierTypeValues[value]; !ok {
+		return "", newEnumParseError(ErrEnergyCarrierTypeParse, value, EnergyCarrierType("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of EnergyCarrierType
+func (EnergyCarrierType) Values() []EnergyCarrierType {
+	return []EnergyCarrierType{
+		DieselEnergy,
+		HVOEnergy,
+		PetrolEnergy,
+		CNGEnergy,
+		LNGEnergy,
+		LPGEnergy,
+		HFOEnergy,
+		MGOEnergy,
+		AviationFuelEnergy,
+		HydrogenEnergy,
+		MethanolEnergy,
+		ElectricEnergy,
+	}
+}
+
+// IsValid reports whether the value is a valid EnergyCarrierType
+func (u EnergyCarrierType) IsValid() bool {
+	_, ok := energyCarrierTypeValues[string(u)]
+	return ok
+}
+
+func (u EnergyCarrierType) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u EnergyCarrierType) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrEnergyCarrierTypeParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *EnergyCarrierType) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseEnergyCarrierType(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u EnergyCarrierType) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *EnergyCarrierType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+type AttachmentType string
+
+// Error parsing the AttachmentType
+var ErrAttachmentTypeParse = errors.New("unsupported AttachmentType")
+
+var attachmentTypeValues = map[string]AttachmentType{
+	"statement": StatementAttachment,
+	"signature": SignatureAttachment,
+}
+
+const (
+	// for an assurance statement
+	StatementAttachment AttachmentType = "statement"
+
+	// for a signature of the assurance provider
+	SignatureAttachment AttachmentType = "signature"
+)
+
+// ParseAttachmentType returns the AttachmentType with the given value,
+// or an *EnumParseError matching ErrAttachmentTypeParse
+func ParseAttachmentType(value string) (AttachmentType, error) {
+	if parsed, ok := attachmentTypeValues[value]; !ok {
+		return "", newEnumParseError(ErrAttachmentTypeParse, value, AttachmentType("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of AttachmentType
+func (AttachmentType) Values() []AttachmentType {
+	return []AttachmentType{
+		StatementAttachment,
+		SignatureAttachment,
+	}
+}
+
+// IsValid reports whether the value is a valid AttachmentType
+func (u AttachmentType) IsValid() bool {
+	_, ok := attachmentTypeValues[string(u)]
+	return ok
+}
+
+func (u AttachmentType) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u AttachmentType) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(ErrAttachmentTypeParse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *AttachmentType) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseAttachmentType(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u AttachmentType) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *AttachmentType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
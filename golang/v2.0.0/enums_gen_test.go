@@ -0,0 +1,1146 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package schema
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusEnum(t *testing.T) {
+
+	values := Status("").Values()
+	assert.Equal(t, []Status{
+		Active,
+		Deprecated,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseStatus(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded Status
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled Status
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := Status("unsupported Status")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrStatusParse)
+
+	var decoded Status
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrStatusParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "Status", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(Status(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, Status(""), decoded, zero)
+	}
+
+}
+
+func TestDeclaredUnitEnum(t *testing.T) {
+
+	values := DeclaredUnit("").Values()
+	assert.Equal(t, []DeclaredUnit{
+		Liter,
+		KiloGram,
+		CubicMeter,
+		KiloWattHour,
+		MegaJoule,
+		TonKilometer,
+		SquareMeter,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseDeclaredUnit(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded DeclaredUnit
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled DeclaredUnit
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := DeclaredUnit("unsupported DeclaredUnit")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrDeclaredUnitParse)
+
+	var decoded DeclaredUnit
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrDeclaredUnitParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "DeclaredUnit", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(DeclaredUnit(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, DeclaredUnit(""), decoded, zero)
+	}
+
+}
+
+func TestCharacterizationFactorEnum(t *testing.T) {
+
+	values := CharacterizationFactor("").Values()
+	assert.Equal(t, []CharacterizationFactor{
+		AR6,
+		AR5,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseCharacterizationFactor(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded CharacterizationFactor
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled CharacterizationFactor
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := CharacterizationFactor("unsupported CharacterizationFactor")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrCharacterizationFactorParse)
+
+	var decoded CharacterizationFactor
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrCharacterizationFactorParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "CharacterizationFactor", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(CharacterizationFactor(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, CharacterizationFactor(""), decoded, zero)
+	}
+
+}
+
+func TestStandardEnum(t *testing.T) {
+
+	values := Standard("").Values()
+	assert.Equal(t, []Standard{
+		GHGProtocol,
+		ISO14067,
+		ISO14044,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseStandard(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded Standard
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled Standard
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := Standard("unsupported Standard")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrStandardParse)
+
+	var decoded Standard
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrStandardParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "Standard", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(Standard(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, Standard(""), decoded, zero)
+	}
+
+}
+
+func TestPCROperatorEnum(t *testing.T) {
+
+	values := PCROperator("").Values()
+	assert.Equal(t, []PCROperator{
+		PEF,
+		EPD,
+		Other,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParsePCROperator(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded PCROperator
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled PCROperator
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := PCROperator("unsupported PCROperator")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrPCROperatorParse)
+
+	var decoded PCROperator
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrPCROperatorParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "PCROperator", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(PCROperator(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, PCROperator(""), decoded, zero)
+	}
+
+}
+
+func TestAccountingMethodologyEnum(t *testing.T) {
+
+	values := AccountingMethodology("").Values()
+	assert.Equal(t, []AccountingMethodology{
+		PEFAccounting,
+		ISOAccounting,
+		GHGPAccounting,
+		QuantisAccounting,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseAccountingMethodology(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded AccountingMethodology
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled AccountingMethodology
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := AccountingMethodology("unsupported AccountingMethodology")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrAccountingMethodologyParse)
+
+	var decoded AccountingMethodology
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrAccountingMethodologyParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "AccountingMethodology", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(AccountingMethodology(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, AccountingMethodology(""), decoded, zero)
+	}
+
+}
+
+func TestCoverageEnum(t *testing.T) {
+
+	values := Coverage("").Values()
+	assert.Equal(t, []Coverage{
+		Corporate,
+		ProductLine,
+		PCF,
+		Product,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseCoverage(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded Coverage
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled Coverage
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := Coverage("unsupported Coverage")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrCoverageParse)
+
+	var decoded Coverage
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrCoverageParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "Coverage", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(Coverage(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, Coverage(""), decoded, zero)
+	}
+
+}
+
+func TestAssuranceLevelEnum(t *testing.T) {
+
+	values := AssuranceLevel("").Values()
+	assert.Equal(t, []AssuranceLevel{
+		Limited,
+		Reasonable,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseAssuranceLevel(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded AssuranceLevel
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled AssuranceLevel
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := AssuranceLevel("unsupported AssuranceLevel")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrAssuranceLevelParse)
+
+	var decoded AssuranceLevel
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrAssuranceLevelParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "AssuranceLevel", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(AssuranceLevel(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, AssuranceLevel(""), decoded, zero)
+	}
+
+}
+
+func TestAssuranceBoundaryEnum(t *testing.T) {
+
+	values := AssuranceBoundary("").Values()
+	assert.Equal(t, []AssuranceBoundary{
+		GateToGate,
+		CradleToGate,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseAssuranceBoundary(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded AssuranceBoundary
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled AssuranceBoundary
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := AssuranceBoundary("unsupported AssuranceBoundary")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrAssuranceBoundaryParse)
+
+	var decoded AssuranceBoundary
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrAssuranceBoundaryParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "AssuranceBoundary", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(AssuranceBoundary(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, AssuranceBoundary(""), decoded, zero)
+	}
+
+}
+
+func TestRegionOrSubregionEnum(t *testing.T) {
+
+	values := RegionOrSubregion("").Values()
+	assert.Equal(t, []RegionOrSubregion{
+		Africa,
+		Americas,
+		Asia,
+		Europe,
+		Oceania,
+		AustraliaAndNewZealand,
+		CentralAsia,
+		EasternAsia,
+		EasternEurope,
+		LatinAmericaAndCaribbean,
+		Melanesia,
+		Micronesia,
+		NorthernAfrica,
+		NorthernAmerica,
+		NorthernEurope,
+		Polynesia,
+		SouthEasternAsia,
+		SouthernAsia,
+		SouthernEurope,
+		SubSaharanAfrica,
+		WesternAsia,
+		WesternEurope,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseRegionOrSubregion(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded RegionOrSubregion
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled RegionOrSubregion
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := RegionOrSubregion("unsupported RegionOrSubregion")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrRegionOrSubregionParse)
+
+	var decoded RegionOrSubregion
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrRegionOrSubregionParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "RegionOrSubregion", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(RegionOrSubregion(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, RegionOrSubregion(""), decoded, zero)
+	}
+
+}
+
+func TestGreenhouseGasEnum(t *testing.T) {
+
+	values := GreenhouseGas("").Values()
+	assert.Equal(t, []GreenhouseGas{
+		CO2,
+		CH4Fossil,
+		CH4Biogenic,
+		N2O,
+		SF6,
+		NF3,
+		HFC23,
+		HFC32,
+		HFC125,
+		HFC134a,
+		HFC143a,
+		HFC152a,
+		HFC227ea,
+		HFC245fa,
+		PFC14,
+		PFC116,
+		PFC218,
+		PFC318,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseGreenhouseGas(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded GreenhouseGas
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled GreenhouseGas
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := GreenhouseGas("unsupported GreenhouseGas")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrGreenhouseGasParse)
+
+	var decoded GreenhouseGas
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrGreenhouseGasParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "GreenhouseGas", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(GreenhouseGas(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, GreenhouseGas(""), decoded, zero)
+	}
+
+}
+
+func TestShipmentTypeEnum(t *testing.T) {
+
+	values := ShipmentType("").Values()
+	assert.Equal(t, []ShipmentType{
+		PickupShipment,
+		DeliveryShipment,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseShipmentType(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded ShipmentType
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled ShipmentType
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := ShipmentType("unsupported ShipmentType")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrShipmentTypeParse)
+
+	var decoded ShipmentType
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrShipmentTypeParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "ShipmentType", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(ShipmentType(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, ShipmentType(""), decoded, zero)
+	}
+
+}
+
+func TestTransportModeEnum(t *testing.T) {
+
+	values := TransportMode("").Values()
+	assert.Equal(t, []TransportMode{
+		RoadTransport,
+		RailTransport,
+		AirTransport,
+		SeaTransport,
+		InlandWaterwayTransport,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseTransportMode(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded TransportMode
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled TransportMode
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := TransportMode("unsupported TransportMode")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrTransportModeParse)
+
+	var decoded TransportMode
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrTransportModeParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "TransportMode", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(TransportMode(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, TransportMode(""), decoded, zero)
+	}
+
+}
+
+func TestHubTypeEnum(t *testing.T) {
+
+	values := HubType("").Values()
+	assert.Equal(t, []HubType{
+		TransshipmentHub,
+		StorageAndTransshipmentHub,
+		WarehouseHub,
+		LiquidBulkTerminalHub,
+		MaritimeContainerTerminalHub,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseHubType(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded HubType
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled HubType
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := HubType("unsupported HubType")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrHubTypeParse)
+
+	var decoded HubType
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrHubTypeParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "HubType", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(HubType(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, HubType(""), decoded, zero)
+	}
+
+}
+
+func TestTemperatureControlEnum(t *testing.T) {
+
+	values := TemperatureControl("").Values()
+	assert.Equal(t, []TemperatureControl{
+		AmbientTemperature,
+		RefrigeratedTemperature,
+		MixedTemperature,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseTemperatureControl(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded TemperatureControl
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled TemperatureControl
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := TemperatureControl("unsupported TemperatureControl")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrTemperatureControlParse)
+
+	var decoded TemperatureControl
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrTemperatureControlParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "TemperatureControl", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(TemperatureControl(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, TemperatureControl(""), decoded, zero)
+	}
+
+}
+
+func TestEnergyCarrierTypeEnum(t *testing.T) {
+
+	values := EnergyCarrierType("").Values()
+	assert.Equal(t, []EnergyCarrierType{
+		DieselEnergy,
+		HVOEnergy,
+		PetrolEnergy,
+		CNGEnergy,
+		LNGEnergy,
+		LPGEnergy,
+		HFOEnergy,
+		MGOEnergy,
+		AviationFuelEnergy,
+		HydrogenEnergy,
+		MethanolEnergy,
+		ElectricEnergy,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseEnergyCarrierType(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded EnergyCarrierType
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled EnergyCarrierType
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := EnergyCarrierType("unsupported EnergyCarrierType")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrEnergyCarrierTypeParse)
+
+	var decoded EnergyCarrierType
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrEnergyCarrierTypeParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "EnergyCarrierType", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(EnergyCarrierType(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, EnergyCarrierType(""), decoded, zero)
+	}
+
+}
+
+func TestAttachmentTypeEnum(t *testing.T) {
+
+	values := AttachmentType("").Values()
+	assert.Equal(t, []AttachmentType{
+		StatementAttachment,
+		SignatureAttachment,
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := ParseAttachmentType(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded AttachmentType
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled AttachmentType
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := AttachmentType("unsupported AttachmentType")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, ErrAttachmentTypeParse)
+
+	var decoded AttachmentType
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, ErrAttachmentTypeParse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "AttachmentType", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal(AttachmentType(""))
+	assert.Nil(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	for _, zero := range []string{`""`, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, AttachmentType(""), decoded, zero)
+	}
+
+}
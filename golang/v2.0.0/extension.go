@@ -15,4 +15,44 @@ type DataModelExtension struct {
 	// Data
 	// Mandatory
 	Data json.RawMessage `json:"data"`
+
+	// The Data decoded into the Go type registered for the DataSchema in DefaultExtensions,
+	// or in the ExtensionRegistry used to decode the extension.
+	// If present, it is encoded as Data on marshal.
+	Value any `json:"-"`
+}
+
+// dataModelExtension has the fields of DataModelExtension without its methods
+type dataModelExtension DataModelExtension
+
+// Encoded returns the Data of the extension, encoded from its Value if present
+func (e DataModelExtension) Encoded() (json.RawMessage, error) {
+	if e.Value == nil {
+		return e.Data, nil
+	}
+	return json.Marshal(e.Value)
+}
+
+func (e DataModelExtension) MarshalJSON() ([]byte, error) {
+	data, err := e.Encoded()
+	if err != nil {
+		return nil, err
+	}
+
+	e.Data = data
+	return json.Marshal(dataModelExtension(e))
+}
+
+// UnmarshalJSON decodes the Data of the extensions registered in DefaultExtensions into their Value.
+// The Data of the other extensions is kept raw, or rejected with the RejectUnknown policy.
+// No warning is reported with the WarnUnknown policy, use DefaultExtensions.Decode to get them.
+func (e *DataModelExtension) UnmarshalJSON(data []byte) error {
+	var extension dataModelExtension
+	if err := json.Unmarshal(data, &extension); err != nil {
+		return err
+	}
+
+	*e = DataModelExtension(extension)
+	_, err := DefaultExtensions.decode(e)
+	return err
 }
@@ -0,0 +1,212 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Error when no Go type is registered for the dataSchema of an extension
+var ErrUnknownExtension = errors.New("unknown extension")
+
+// Error when a Go type is registered for several dataSchemas
+var ErrExtensionType = errors.New("extension type registered for another dataSchema")
+
+// The version of the Data Model Extensions specification of the extensions created with NewExtension
+const ExtensionSpecVersion = "2.0.0"
+
+// UnknownExtensionPolicy is the handling of the extensions without a registered Go type
+type UnknownExtensionPolicy int
+
+const (
+	// the Data of the extension is kept raw
+	KeepUnknown UnknownExtensionPolicy = iota
+
+	// the Data of the extension is kept raw and a warning is reported by ExtensionRegistry.Decode,
+	// unmarshalling a ProductFootprint does not report warnings
+	WarnUnknown
+
+	// the extension is rejected with ErrUnknownExtension
+	RejectUnknown
+)
+
+// extensionKey identifies an extension schema, an empty specVersion matches every version
+type extensionKey struct {
+	dataSchema  string
+	specVersion string
+}
+
+// ExtensionRegistry maps the dataSchema URLs, and optionally the specVersions,
+// of DataModelExtensions to the Go types their Data is decoded into.
+// It is safe for concurrent use, the Policy must be set before the registry is used.
+// The zero value is not usable, use NewExtensionRegistry.
+type ExtensionRegistry struct {
+
+	// The handling of the extensions without a registered Go type
+	Policy UnknownExtensionPolicy
+
+	mu      sync.RWMutex
+	types   map[extensionKey]reflect.Type
+	keys    map[reflect.Type]extensionKey
+	aliases map[string]string
+}
+
+// DefaultExtensions is the registry used to decode extensions when unmarshalling a ProductFootprint
+var DefaultExtensions = NewExtensionRegistry()
+
+// NewExtensionRegistry returns an empty ExtensionRegistry keeping unknown extensions raw
+func NewExtensionRegistry() *ExtensionRegistry {
+	return &ExtensionRegistry{
+		types:   map[extensionKey]reflect.Type{},
+		keys:    map[reflect.Type]extensionKey{},
+		aliases: map[string]string{},
+	}
+}
+
+// RegisterExtension registers the Go type T for the dataSchema and the given specVersions,
+// or every specVersion if none is given
+func RegisterExtension[T any](r *ExtensionRegistry, dataSchema string, specVersions ...string) error {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if key, ok := r.keys[t]; ok && key.dataSchema != dataSchema {
+		return fmt.Errorf("%w: %s for %s", ErrExtensionType, t, key.dataSchema)
+	}
+
+	if len(specVersions) == 0 {
+		specVersions = []string{""}
+	}
+	for _, specVersion := range specVersions {
+		key := extensionKey{dataSchema: dataSchema, specVersion: specVersion}
+		r.types[key] = t
+		if _, ok := r.keys[t]; !ok {
+			r.keys[t] = key
+		}
+	}
+	return nil
+}
+
+// RegisterAlias decodes the extensions with the dataSchema alias as the extensions with the dataSchema,
+// e.g. when an extension schema is published under several URLs
+func (r *ExtensionRegistry) RegisterAlias(alias, dataSchema string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.aliases[alias] = dataSchema
+}
+
+// lookup returns the Go type registered for the extension
+func (r *ExtensionRegistry) lookup(e DataModelExtension) (reflect.Type, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dataSchema := e.DataSchema
+	if canonical, ok := r.aliases[dataSchema]; ok {
+		dataSchema = canonical
+	}
+	if t, ok := r.types[extensionKey{dataSchema: dataSchema, specVersion: e.SpecVersion}]; ok {
+		return t, true
+	}
+	t, ok := r.types[extensionKey{dataSchema: dataSchema}]
+	return t, ok
+}
+
+// decode decodes the Data of the extension into its Value, and reports whether the extension is known
+func (r *ExtensionRegistry) decode(e *DataModelExtension) (bool, error) {
+	t, ok := r.lookup(*e)
+	if !ok {
+		if r.Policy == RejectUnknown {
+			return false, fmt.Errorf("%w: %s", ErrUnknownExtension, e.DataSchema)
+		}
+		return false, nil
+	}
+
+	value := reflect.New(t)
+	if err := json.Unmarshal(e.Data, value.Interface()); err != nil {
+		return true, fmt.Errorf("%s: %w", e.DataSchema, err)
+	}
+
+	e.Value = value.Elem().Interface()
+	return true, nil
+}
+
+// Decode decodes the Data of the extensions of the ProductFootprint into their Value.
+// Unknown extensions are handled according to the Policy, a warning being returned
+// for every unknown extension with WarnUnknown.
+func (r *ExtensionRegistry) Decode(p *ProductFootprint) ([]string, error) {
+	var warnings []string
+	var errs []error
+	for i := range p.Extensions {
+		known, err := r.decode(&p.Extensions[i])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("extensions[%d]: %w", i, err))
+			continue
+		}
+		if !known && r.Policy == WarnUnknown {
+			warnings = append(warnings, fmt.Sprintf("extensions[%d]: %s: %s", i, ErrUnknownExtension, p.Extensions[i].DataSchema))
+		}
+	}
+	return warnings, errors.Join(errs...)
+}
+
+// NewExtension returns the extension of the value with the dataSchema
+// and specVersion its Go type is registered for
+func NewExtension[T any](r *ExtensionRegistry, value T) (DataModelExtension, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	r.mu.RLock()
+	key, ok := r.keys[t]
+	r.mu.RUnlock()
+	if !ok {
+		return DataModelExtension{}, fmt.Errorf("%w: %s", ErrUnknownExtension, t)
+	}
+
+	extension := DataModelExtension{SpecVersion: key.specVersion, DataSchema: key.dataSchema, Value: value}
+	if extension.SpecVersion == "" {
+		extension.SpecVersion = ExtensionSpecVersion
+	}
+
+	data, err := extension.Encoded()
+	extension.Data = data
+	return extension, err
+}
+
+// GetExtension returns the Value of the first extension of the ProductFootprint of type T
+func GetExtension[T any](p ProductFootprint) (T, bool) {
+	for _, extension := range p.Extensions {
+		if value, ok := extension.Value.(T); ok {
+			return value, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// GetExtensions returns the Values of all the extensions of the ProductFootprint of type T
+func GetExtensions[T any](p ProductFootprint) []T {
+	var values []T
+	for _, extension := range p.Extensions {
+		if value, ok := extension.Value.(T); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// ValidateExtensions validates the Value of the extensions of the ProductFootprint
+// having a Validate() error method, e.g. the logistics extensions
+func (p ProductFootprint) ValidateExtensions() error {
+	var errs []error
+	for i, extension := range p.Extensions {
+		if validator, ok := extension.Value.(interface{ Validate() error }); ok {
+			if err := validator.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("extensions[%d]: %w", i, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testExtension struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+const testExtensionSchema = "https://example.com/test/1.0.0/data-model.json"
+
+func TestExtensionRegistry(t *testing.T) {
+
+	registry := NewExtensionRegistry()
+	assert.Nil(t, RegisterExtension[testExtension](registry, testExtensionSchema))
+	assert.ErrorIs(t, RegisterExtension[testExtension](registry, "https://example.com/other.json"), ErrExtensionType)
+
+	product := ProductFootprint{Extensions: []DataModelExtension{
+		{SpecVersion: "2.0.0", DataSchema: testExtensionSchema, Data: json.RawMessage(`{"name":"a","count":1}`)},
+		{SpecVersion: "2.0.0", DataSchema: "https://example.com/unknown.json", Data: json.RawMessage(`{"x":1}`)},
+	}}
+
+	warnings, err := registry.Decode(&product)
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+
+	value, ok := GetExtension[testExtension](product)
+	assert.True(t, ok)
+	assert.Equal(t, testExtension{Name: "a", Count: 1}, value)
+	assert.Len(t, GetExtensions[testExtension](product), 1)
+
+	registry.Policy = WarnUnknown
+	warnings, err = registry.Decode(&product)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"extensions[1]: unknown extension: https://example.com/unknown.json"}, warnings)
+
+	registry.Policy = RejectUnknown
+	_, err = registry.Decode(&product)
+	assert.ErrorIs(t, err, ErrUnknownExtension)
+
+	product.Extensions[0].Value = testExtension{Name: "b", Count: 2}
+	data, err := json.Marshal(product.Extensions[0])
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"specVersion":"2.0.0","dataSchema":"`+testExtensionSchema+`","data":{"name":"b","count":2}}`, string(data))
+
+	extension, err := NewExtension(registry, testExtension{Name: "c"})
+	assert.Nil(t, err)
+	assert.Equal(t, testExtensionSchema, extension.DataSchema)
+	assert.JSONEq(t, `{"name":"c","count":0}`, string(extension.Data))
+
+}
+
+func TestDefaultExtensions(t *testing.T) {
+
+	// the test registers its extension in a copy of the default registry
+	previous := DefaultExtensions
+	t.Cleanup(func() {
+		DefaultExtensions = previous
+	})
+	DefaultExtensions = NewExtensionRegistry()
+	assert.Nil(t, RegisterLogisticsExtensions(DefaultExtensions))
+	assert.Nil(t, RegisterExtension[testExtension](DefaultExtensions, testExtensionSchema))
+
+	var product ProductFootprint
+	err := json.Unmarshal([]byte(`{"extensions":[{"specVersion":"2.0.0","dataSchema":"`+testExtensionSchema+`","data":{"name":"a","count":1}}]}`), &product)
+	assert.Nil(t, err)
+
+	value, ok := GetExtension[testExtension](product)
+	assert.True(t, ok)
+	assert.Equal(t, "a", value.Name)
+
+	err = json.Unmarshal([]byte(`{"extensions":[{"specVersion":"2.0.0","dataSchema":"`+testExtensionSchema+`","data":{"count":"1"}}]}`), &product)
+	assert.Error(t, err)
+
+}
+
+func TestExtensionRegistryConcurrency(t *testing.T) {
+
+	registry := NewExtensionRegistry()
+	data := []byte(`{"extensions":[{"specVersion":"2.0.0","dataSchema":"` + testExtensionSchema + `","data":{"name":"a","count":1}}]}`)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, RegisterExtension[testExtension](registry, testExtensionSchema))
+		}()
+		go func() {
+			defer wg.Done()
+			var product ProductFootprint
+			assert.Nil(t, json.Unmarshal(data, &product))
+			_, err := registry.Decode(&product)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	_, ok := registry.lookup(DataModelExtension{SpecVersion: "2.0.0", DataSchema: testExtensionSchema})
+	assert.True(t, ok)
+
+}
@@ -0,0 +1,166 @@
+package schema
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Error when a country code is not part of the UN M49 hierarchy
+var ErrUnknownCountry = errors.New("unknown country")
+
+// Error when a CarbonFootprint does not declare any geography
+var ErrGeographyUndefined = errors.New("geography undefined")
+
+// The ISO 3166-1 countries with their name and UN M49 subregion.
+// Taiwan, not listed separately by the UN M49, is classified under Eastern Asia.
+//
+//go:embed data/m49.csv
+var m49CSV string
+
+// The UN M49 subregions of every geographic region
+var regionSubregions = map[RegionOrSubregion][]RegionOrSubregion{
+	Africa:   {NorthernAfrica, SubSaharanAfrica},
+	Americas: {LatinAmericaAndCaribbean, NorthernAmerica},
+	Asia:     {CentralAsia, EasternAsia, SouthEasternAsia, SouthernAsia, WesternAsia},
+	Europe:   {EasternEurope, NorthernEurope, SouthernEurope, WesternEurope},
+	Oceania:  {AustraliaAndNewZealand, Melanesia, Micronesia, Polynesia},
+}
+
+// m49Country is an entry of the embedded UN M49 table
+type m49Country struct {
+	code      string
+	name      string
+	subregion RegionOrSubregion
+}
+
+var countries, subregionCountries = loadM49()
+
+func loadM49() (map[string]m49Country, map[RegionOrSubregion][]string) {
+	records, err := csv.NewReader(strings.NewReader(m49CSV)).ReadAll()
+	if err != nil {
+		panic("invalid UN M49 table: " + err.Error())
+	}
+
+	byCode := map[string]m49Country{}
+	bySubregion := map[RegionOrSubregion][]string{}
+	for _, record := range records[1:] {
+		entry := m49Country{code: record[0], name: record[1], subregion: RegionOrSubregion(record[2])}
+		byCode[entry.code] = entry
+		if entry.subregion != "" {
+			bySubregion[entry.subregion] = append(bySubregion[entry.subregion], entry.code)
+		}
+	}
+
+	for _, codes := range bySubregion {
+		sort.Strings(codes)
+	}
+
+	return byCode, bySubregion
+}
+
+// IsRegion reports whether the value is a UN geographic region rather than a subregion
+func (u RegionOrSubregion) IsRegion() bool {
+	_, ok := regionSubregions[u]
+	return ok
+}
+
+// Region returns the region of a subregion, or the region itself
+func (u RegionOrSubregion) Region() RegionOrSubregion {
+	if u.IsRegion() {
+		return u
+	}
+
+	for region, subregions := range regionSubregions {
+		for _, subregion := range subregions {
+			if subregion == u {
+				return region
+			}
+		}
+	}
+
+	return ""
+}
+
+// Subregions returns the subregions of a region, or nothing for a subregion
+func (u RegionOrSubregion) Subregions() []RegionOrSubregion {
+	return append([]RegionOrSubregion(nil), regionSubregions[u]...)
+}
+
+// Contains reports whether the region or subregion other is u or part of u
+func (u RegionOrSubregion) Contains(other RegionOrSubregion) bool {
+	return u == other || (u.IsRegion() && other.Region() == u)
+}
+
+// Countries returns the ISO 3166-1 alpha-2 codes of the countries of the region or subregion
+func (u RegionOrSubregion) Countries() []string {
+	if !u.IsRegion() {
+		return append([]string(nil), subregionCountries[u]...)
+	}
+
+	var codes []string
+	for _, subregion := range regionSubregions[u] {
+		codes = append(codes, subregionCountries[subregion]...)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// CountrySubregion returns the UN M49 subregion of an ISO 3166-1 alpha-2 country code
+func CountrySubregion(code string) (RegionOrSubregion, error) {
+	entry, ok := countries[strings.ToUpper(code)]
+	if !ok || entry.subregion == "" {
+		return "", ErrUnknownCountry
+	}
+	return entry.subregion, nil
+}
+
+// CountryRegion returns the UN M49 region of an ISO 3166-1 alpha-2 country code
+func CountryRegion(code string) (RegionOrSubregion, error) {
+	subregion, err := CountrySubregion(code)
+	if err != nil {
+		return "", err
+	}
+	return subregion.Region(), nil
+}
+
+// InRegion reports whether the country, given as ISO 3166-1 alpha-2 code
+// or ISO 3166-2 subdivision code, is part of the region or subregion
+func InRegion(code string, region RegionOrSubregion) bool {
+	subregion, err := CountrySubregion(SubdivisionCountry(code))
+	return err == nil && region.Contains(subregion)
+}
+
+// SubdivisionCountry returns the ISO 3166-1 alpha-2 country code of an ISO 3166-2 subdivision code,
+// e.g. US for US-NY, or the code itself if it is not a subdivision code
+func SubdivisionCountry(code string) string {
+	country, _, _ := strings.Cut(code, "-")
+	return strings.ToUpper(country)
+}
+
+// Subregion returns the UN M49 subregion of the geographic scope of the CarbonFootprint,
+// derived from the most specific geography declared.
+// For a CarbonFootprint scoped to a region, the region itself is returned.
+func (c CarbonFootprint) Subregion() (RegionOrSubregion, error) {
+	switch {
+	case c.GeographyCountrySubdivision != "":
+		return CountrySubregion(c.GeographyCountrySubdivision.Country().String())
+	case c.GeographyCountry != "":
+		return CountrySubregion(c.GeographyCountry.String())
+	case c.GeographyRegionOrSubregion != "":
+		return c.GeographyRegionOrSubregion, nil
+	}
+	return "", ErrGeographyUndefined
+}
+
+// Region returns the UN M49 region of the geographic scope of the CarbonFootprint,
+// whatever the granularity of the geography declared
+func (c CarbonFootprint) Region() (RegionOrSubregion, error) {
+	subregion, err := c.Subregion()
+	if err != nil {
+		return "", err
+	}
+	return subregion.Region(), nil
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestM49Hierarchy(t *testing.T) {
+
+	for region, subregions := range regionSubregions {
+		assert.True(t, region.IsRegion())
+		for _, subregion := range subregions {
+			assert.True(t, subregion.IsValid())
+			assert.False(t, subregion.IsRegion())
+			assert.Equal(t, region, subregion.Region())
+			assert.NotEmpty(t, subregion.Countries(), subregion)
+		}
+	}
+
+	for _, value := range RegionOrSubregion("").Values() {
+		assert.NotEmpty(t, value.Region(), value)
+	}
+
+	assert.Len(t, countries, 249)
+
+}
+
+func TestCountryLookup(t *testing.T) {
+
+	assert.True(t, InRegion("FR", WesternEurope))
+	assert.True(t, InRegion("FR", Europe))
+	assert.False(t, InRegion("FR", EasternEurope))
+	assert.True(t, InRegion("US-NY", NorthernAmerica))
+	assert.False(t, InRegion("AQ", Americas))
+
+	subregion, err := CountrySubregion("us")
+	assert.Nil(t, err)
+	assert.Equal(t, NorthernAmerica, subregion)
+
+	region, err := CountryRegion("MX")
+	assert.Nil(t, err)
+	assert.Equal(t, Americas, region)
+
+	_, err = CountryRegion("XX")
+	assert.ErrorIs(t, err, ErrUnknownCountry)
+
+	assert.Contains(t, Europe.Countries(), "DE")
+	assert.Contains(t, WesternEurope.Countries(), "DE")
+
+}
+
+func TestCarbonFootprintRegion(t *testing.T) {
+
+	footprint := CarbonFootprint{GeographyCountrySubdivision: "FR-89"}
+	region, err := footprint.Region()
+	assert.Nil(t, err)
+	assert.Equal(t, Europe, region)
+
+	footprint = CarbonFootprint{GeographyCountry: "JP"}
+	subregion, err := footprint.Subregion()
+	assert.Nil(t, err)
+	assert.Equal(t, EasternAsia, subregion)
+
+	footprint = CarbonFootprint{GeographyRegionOrSubregion: Melanesia}
+	region, err = footprint.Region()
+	assert.Nil(t, err)
+	assert.Equal(t, Oceania, region)
+
+	_, err = CarbonFootprint{}.Region()
+	assert.ErrorIs(t, err, ErrGeographyUndefined)
+
+}
@@ -0,0 +1,114 @@
+package schema
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// Error when no GWP is known for a gas under a CharacterizationFactor
+var ErrGWPUnknown = errors.New("no GWP for the GreenhouseGas and CharacterizationFactor")
+
+// The 100-year global warming potentials of the IPCC Assessment Reports.
+//
+// AR5:
+//
+//	IPCC AR5 WG1 Chapter 8, Table 8.A.1, values including climate-carbon feedbacks.
+//
+// AR6:
+//
+//	IPCC AR6 WG1 Chapter 7, Table 7.15 and Table 7.SM.7.
+//
+// In line with the Pathfinder Framework both include carbon feedbacks.
+var gwp100 = map[CharacterizationFactor]map[GreenhouseGas]decimal.Decimal{
+	AR5: {
+		CO2:         decimal.NewFromInt(1),
+		CH4Fossil:   decimal.NewFromInt(36),
+		CH4Biogenic: decimal.NewFromInt(34),
+		N2O:         decimal.NewFromInt(298),
+		SF6:         decimal.NewFromInt(26087),
+		NF3:         decimal.NewFromInt(17885),
+		HFC23:       decimal.NewFromInt(13856),
+		HFC32:       decimal.NewFromInt(817),
+		HFC125:      decimal.NewFromInt(3691),
+		HFC134a:     decimal.NewFromInt(1549),
+		HFC143a:     decimal.NewFromInt(5508),
+		HFC152a:     decimal.NewFromInt(167),
+		HFC227ea:    decimal.NewFromInt(3860),
+		HFC245fa:    decimal.NewFromInt(1032),
+		PFC14:       decimal.NewFromInt(7349),
+		PFC116:      decimal.NewFromInt(12340),
+		PFC218:      decimal.NewFromInt(9878),
+		PFC318:      decimal.NewFromInt(10592),
+	},
+	AR6: {
+		CO2:         decimal.NewFromInt(1),
+		CH4Fossil:   decimal.RequireFromString("29.8"),
+		CH4Biogenic: decimal.RequireFromString("27.2"),
+		N2O:         decimal.NewFromInt(273),
+		SF6:         decimal.NewFromInt(25200),
+		NF3:         decimal.NewFromInt(17400),
+		HFC23:       decimal.NewFromInt(14600),
+		HFC32:       decimal.NewFromInt(771),
+		HFC125:      decimal.NewFromInt(3740),
+		HFC134a:     decimal.NewFromInt(1530),
+		HFC143a:     decimal.NewFromInt(5810),
+		HFC152a:     decimal.NewFromInt(164),
+		HFC227ea:    decimal.NewFromInt(3600),
+		HFC245fa:    decimal.NewFromInt(962),
+		PFC14:       decimal.NewFromInt(7380),
+		PFC116:      decimal.NewFromInt(12400),
+		PFC218:      decimal.NewFromInt(9290),
+		PFC318:      decimal.NewFromInt(10200),
+	},
+}
+
+// GWP returns the 100-year global warming potential (kgCO2e / kg) of the gas
+// under the given CharacterizationFactor
+func GWP(gas GreenhouseGas, factor CharacterizationFactor) (decimal.Decimal, error) {
+	table, ok := gwp100[factor]
+	if !ok {
+		return decimal.Zero, ErrCharacterizationFactorParse
+	}
+
+	value, ok := table[gas]
+	if !ok {
+		return decimal.Zero, ErrGWPUnknown
+	}
+
+	return value, nil
+}
+
+// GasInventory is the emissions of every greenhouse gas in kg of the gas
+// (not CO2e), e.g. per declared unit of a product.
+// Its JSON keys are validated through GreenhouseGas.UnmarshalText.
+type GasInventory map[GreenhouseGas]decimal.Decimal
+
+// CO2eByGas converts the emissions of every gas of the inventory into kgCO2e
+// using the GWPs of the given CharacterizationFactor
+func (i GasInventory) CO2eByGas(factor CharacterizationFactor) (map[GreenhouseGas]decimal.Decimal, error) {
+	result := make(map[GreenhouseGas]decimal.Decimal, len(i))
+	for gas, amount := range i {
+		gwp, err := GWP(gas, factor)
+		if err != nil {
+			return nil, err
+		}
+		result[gas] = amount.Mul(gwp)
+	}
+	return result, nil
+}
+
+// CO2e returns the total emissions of the inventory in kgCO2e
+// using the GWPs of the given CharacterizationFactor
+func (i GasInventory) CO2e(factor CharacterizationFactor) (decimal.Decimal, error) {
+	byGas, err := i.CO2eByGas(factor)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	total := decimal.Zero
+	for _, value := range byGas {
+		total = total.Add(value)
+	}
+	return total, nil
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGWPTablesComplete(t *testing.T) {
+
+	for _, factor := range CharacterizationFactor("").Values() {
+		for _, gas := range GreenhouseGas("").Values() {
+			_, err := GWP(gas, factor)
+			assert.Nil(t, err, "%s %s", gas, factor)
+		}
+	}
+
+}
+
+func TestGasInventoryCO2e(t *testing.T) {
+
+	testData := `{
+		"CO2": "10",
+		"CH4 fossil": "0.5",
+		"N2O": "0.01"
+	}`
+
+	var inventory GasInventory
+	err := json.Unmarshal([]byte(testData), &inventory)
+	assert.Nil(t, err)
+
+	total, err := inventory.CO2e(AR6)
+	assert.Nil(t, err)
+	assert.True(t, total.Equal(decimal.RequireFromString("27.63")))
+
+	total, err = inventory.CO2e(AR5)
+	assert.Nil(t, err)
+	assert.True(t, total.Equal(decimal.RequireFromString("30.98")))
+
+	_, err = inventory.CO2e(CharacterizationFactor("AR4"))
+	assert.ErrorIs(t, err, ErrCharacterizationFactorParse)
+
+	err = json.Unmarshal([]byte(`{"H2O": "1"}`), &inventory)
+	assert.ErrorIs(t, err, ErrGreenhouseGasParse)
+
+}
@@ -0,0 +1,278 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	urn "github.com/leodido/go-urn"
+)
+
+// Error when a URN does not belong to one of the supported identifier schemes
+var ErrIdentifierScheme = errors.New("unsupported identifier scheme")
+
+// Error when an identifier is not of the expected kind, e.g. a GTIN in companyIds
+var ErrIdentifierKind = errors.New("identifier of the wrong kind")
+
+// Error parsing a GTIN
+var ErrGTINFormat = errors.New("GTIN must have 8, 12, 13 or 14 digits")
+
+// Error validating the check digit of a GTIN
+var ErrGTINCheckDigit = errors.New("invalid GTIN check digit")
+
+// Error parsing an EPC pure identity URN
+var ErrEPCFormat = errors.New("invalid EPC pure identity")
+
+// Error parsing or validating the check digits of a LEI
+var ErrLEIFormat = errors.New("invalid LEI")
+
+// Error parsing a UUID
+var ErrUUIDFormat = errors.New("invalid UUID")
+
+// Error parsing a custom code
+var ErrCustomCodeFormat = errors.New("invalid custom code")
+
+// IdentifierScheme is the scheme of a company or product identifier URN
+type IdentifierScheme string
+
+const (
+	// urn:gtin:4712345060507
+	GTINScheme IdentifierScheme = "gtin"
+
+	// urn:epc:id:sgtin:4712345.006050.7
+	SGTINScheme IdentifierScheme = "sgtin"
+
+	// urn:epc:id:sgln:4063973.00000.8
+	SGLNScheme IdentifierScheme = "sgln"
+
+	// urn:lei:5493001KJTIIGC8Y1R12
+	LEIScheme IdentifierScheme = "lei"
+
+	// urn:uuid:51131FB5-42A2-4267-A402-0ECFEFAD1619
+	UUIDScheme IdentifierScheme = "uuid"
+
+	// urn:pathfinder:company:customcode:buyer-assigned:1234
+	CompanyBuyerAssignedScheme IdentifierScheme = "company:buyer-assigned"
+
+	// urn:pathfinder:company:customcode:vendor-assigned:1234
+	CompanyVendorAssignedScheme IdentifierScheme = "company:vendor-assigned"
+
+	// urn:pathfinder:product:customcode:buyer-assigned:1234
+	ProductBuyerAssignedScheme IdentifierScheme = "product:buyer-assigned"
+
+	// urn:pathfinder:product:customcode:vendor-assigned:1234
+	ProductVendorAssignedScheme IdentifierScheme = "product:vendor-assigned"
+)
+
+// Identifier is a company or product identifier URN parsed according to its scheme
+type Identifier struct {
+
+	// The URN as received
+	URN urn.URN
+
+	// The identifier scheme
+	Scheme IdentifierScheme
+
+	// The scheme specific value: the digits of a GTIN, the EPC pure identity without
+	// its prefix (e.g. 4712345.006050.7), the LEI, the UUID in lower case or the custom code
+	Value string
+}
+
+var (
+	gtinFormat       = regexp.MustCompile(`^([0-9]{8}|[0-9]{12,14})$`)
+	epcFormat        = regexp.MustCompile(`^([0-9]+)\.([0-9]*)\.(.+)$`)
+	leiFormat        = regexp.MustCompile(`^[0-9A-Z]{18}[0-9]{2}$`)
+	customCodeFormat = regexp.MustCompile(`^[^\s]+$`)
+)
+
+// ParseIdentifier parses a URN according to its identifier scheme
+func ParseIdentifier(u urn.URN) (Identifier, error) {
+	id := Identifier{URN: u}
+	nss := u.SS
+
+	switch strings.ToLower(u.ID) {
+	case "gtin":
+		id.Scheme, id.Value = GTINScheme, nss
+		return id, validateGTIN(nss)
+
+	case "epc":
+		prefix, value, _ := strings.Cut(nss, ":")
+		scheme, value, _ := strings.Cut(value, ":")
+		if strings.ToLower(prefix) != "id" {
+			break
+		}
+		switch strings.ToLower(scheme) {
+		case "sgtin":
+			id.Scheme, id.Value = SGTINScheme, value
+			return id, validateEPC(value, 13)
+		case "sgln":
+			id.Scheme, id.Value = SGLNScheme, value
+			return id, validateEPC(value, 12)
+		}
+
+	case "lei":
+		id.Scheme, id.Value = LEIScheme, nss
+		return id, validateLEI(nss)
+
+	case "uuid":
+		id.Scheme, id.Value = UUIDScheme, strings.ToLower(nss)
+		if _, err := uuid.Parse(nss); err != nil || len(nss) != 36 {
+			return id, ErrUUIDFormat
+		}
+		return id, nil
+
+	case "pathfinder":
+		parts := strings.SplitN(nss, ":", 4)
+		if len(parts) != 4 || strings.ToLower(parts[1]) != "customcode" {
+			break
+		}
+		kind, assignment := strings.ToLower(parts[0]), strings.ToLower(parts[2])
+		if (kind != "company" && kind != "product") || (assignment != "buyer-assigned" && assignment != "vendor-assigned") {
+			break
+		}
+		id.Scheme, id.Value = IdentifierScheme(kind+":"+assignment), parts[3]
+		if !customCodeFormat.MatchString(id.Value) {
+			return id, ErrCustomCodeFormat
+		}
+		return id, nil
+	}
+
+	return id, ErrIdentifierScheme
+}
+
+// IsCompany reports whether the identifier scheme identifies companies
+func (i Identifier) IsCompany() bool {
+	switch i.Scheme {
+	case SGLNScheme, LEIScheme, UUIDScheme, CompanyBuyerAssignedScheme, CompanyVendorAssignedScheme:
+		return true
+	}
+	return false
+}
+
+// IsProduct reports whether the identifier scheme identifies products
+func (i Identifier) IsProduct() bool {
+	switch i.Scheme {
+	case GTINScheme, SGTINScheme, UUIDScheme, ProductBuyerAssignedScheme, ProductVendorAssignedScheme:
+		return true
+	}
+	return false
+}
+
+func (i Identifier) String() string {
+	return i.URN.String()
+}
+
+// validateGTIN validates the length and the GS1 check digit of a GTIN
+func validateGTIN(value string) error {
+	if !gtinFormat.MatchString(value) {
+		return ErrGTINFormat
+	}
+	if gs1CheckDigit(value[:len(value)-1]) != value[len(value)-1] {
+		return ErrGTINCheckDigit
+	}
+	return nil
+}
+
+// gs1CheckDigit computes the GS1 modulo 10 check digit of the digits
+func gs1CheckDigit(digits string) byte {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		digit := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// validateEPC validates an SGTIN or SGLN pure identity, whose company prefix
+// and item or location reference have the given total number of digits
+func validateEPC(value string, digits int) error {
+	match := epcFormat.FindStringSubmatch(value)
+	if match == nil || len(match[1]) < 6 || len(match[1]) > 12 || len(match[1])+len(match[2]) != digits {
+		return fmt.Errorf("%w: %q", ErrEPCFormat, value)
+	}
+	return nil
+}
+
+// validateLEI validates the format and the ISO 17442 check digits of a LEI
+func validateLEI(value string) error {
+	if !leiFormat.MatchString(value) {
+		return ErrLEIFormat
+	}
+
+	remainder := 0
+	for _, r := range value {
+		if r >= 'A' {
+			remainder = (remainder*100 + int(r-'A'+10)) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+	if remainder != 1 {
+		return ErrLEIFormat
+	}
+	return nil
+}
+
+// IdentifierError is the error of an entry of the companyIds or productIds
+type IdentifierError struct {
+
+	// The JSON path of the entry, e.g. productIds[1]
+	Path string
+
+	// The URN of the entry
+	URN string
+
+	Err error
+}
+
+func (e *IdentifierError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", e.Path, e.URN, e.Err)
+}
+
+func (e *IdentifierError) Unwrap() error {
+	return e.Err
+}
+
+// parseIdentifiers parses the entries of companyIds or productIds,
+// checking they are of the expected kind
+func parseIdentifiers(field string, values []urn.URN, kind func(Identifier) bool) ([]Identifier, error) {
+	var identifiers []Identifier
+	var errs []error
+	for i, value := range values {
+		identifier, err := ParseIdentifier(value)
+		if err == nil && !kind(identifier) {
+			err = fmt.Errorf("%w: %s", ErrIdentifierKind, identifier.Scheme)
+		}
+		if err != nil {
+			errs = append(errs, &IdentifierError{Path: fmt.Sprintf("%s[%d]", field, i), URN: value.String(), Err: err})
+			continue
+		}
+		identifiers = append(identifiers, identifier)
+	}
+	return identifiers, errors.Join(errs...)
+}
+
+// CompanyIdentifiers parses the companyIds of the ProductFootprint.
+// It returns the valid identifiers and an *IdentifierError for every invalid entry.
+func (p ProductFootprint) CompanyIdentifiers() ([]Identifier, error) {
+	return parseIdentifiers("companyIds", p.CompanyIds, Identifier.IsCompany)
+}
+
+// ProductIdentifiers parses the productIds of the ProductFootprint.
+// It returns the valid identifiers and an *IdentifierError for every invalid entry.
+func (p ProductFootprint) ProductIdentifiers() ([]Identifier, error) {
+	return parseIdentifiers("productIds", p.ProductIds, Identifier.IsProduct)
+}
+
+// ValidateIdentifiers checks the companyIds and productIds of the ProductFootprint
+// belong to the supported identifier schemes
+func (p ProductFootprint) ValidateIdentifiers() error {
+	_, companyErr := p.CompanyIdentifiers()
+	_, productErr := p.ProductIdentifiers()
+	return errors.Join(companyErr, productErr)
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"testing"
+
+	urn "github.com/leodido/go-urn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIdentifier(t *testing.T) {
+
+	for value, scheme := range map[string]IdentifierScheme{
+		"urn:gtin:4712345060507":                                     GTINScheme,
+		"urn:gtin:96385074":                                          GTINScheme,
+		"urn:epc:id:sgtin:0614141.112345.400":                        SGTINScheme,
+		"urn:epc:id:sgln:4063973.00000.8":                            SGLNScheme,
+		"urn:lei:5493001KJTIIGC8Y1R12":                               LEIScheme,
+		"urn:uuid:51131FB5-42A2-4267-A402-0ECFEFAD1619":              UUIDScheme,
+		"urn:pathfinder:company:customcode:buyer-assigned:acme-1234": CompanyBuyerAssignedScheme,
+		"urn:pathfinder:company:customcode:vendor-assigned:1234":     CompanyVendorAssignedScheme,
+		"urn:pathfinder:product:customcode:vendor-assigned:A.1":      ProductVendorAssignedScheme,
+	} {
+		identifier, err := ParseIdentifier(mustURN(t, value))
+		assert.Nil(t, err, value)
+		assert.Equal(t, scheme, identifier.Scheme, value)
+	}
+
+	for value, expected := range map[string]error{
+		"urn:gtin:4712345060508":                               ErrGTINCheckDigit,
+		"urn:gtin:471234506050":                                ErrGTINCheckDigit,
+		"urn:gtin:47123450605":                                 ErrGTINFormat,
+		"urn:epc:id:sgtin:0614141.1123456.400":                 ErrEPCFormat,
+		"urn:epc:id:sgln:4063973.00000":                        ErrEPCFormat,
+		"urn:epc:id:sscc:0614141.1234567890":                   ErrIdentifierScheme,
+		"urn:lei:5493001KJTIIGC8Y1R13":                         ErrLEIFormat,
+		"urn:uuid:51131FB5-42A2-4267-A402":                     ErrUUIDFormat,
+		"urn:pathfinder:company:customcode:self-assigned:1234": ErrIdentifierScheme,
+		"urn:isbn:0451450523":                                  ErrIdentifierScheme,
+	} {
+		_, err := ParseIdentifier(mustURN(t, value))
+		assert.ErrorIs(t, err, expected, value)
+	}
+
+}
+
+func TestProductFootprintIdentifiers(t *testing.T) {
+
+	product := ProductFootprint{
+		CompanyIds: []urn.URN{
+			mustURN(t, "urn:lei:5493001KJTIIGC8Y1R12"),
+			mustURN(t, "urn:gtin:4712345060507"),
+		},
+		ProductIds: []urn.URN{
+			mustURN(t, "urn:gtin:4712345060507"),
+			mustURN(t, "urn:gtin:4712345060508"),
+		},
+	}
+
+	companies, err := product.CompanyIdentifiers()
+	assert.Len(t, companies, 1)
+	assert.ErrorIs(t, err, ErrIdentifierKind)
+
+	products, err := product.ProductIdentifiers()
+	assert.Len(t, products, 1)
+	assert.EqualError(t, err, "productIds[1]: urn:gtin:4712345060508: invalid GTIN check digit")
+
+	err = product.ValidateIdentifiers()
+	var identifierErr *IdentifierError
+	assert.ErrorAs(t, err, &identifierErr)
+	assert.Equal(t, "companyIds[1]", identifierErr.Path)
+
+}
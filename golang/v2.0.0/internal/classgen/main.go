@@ -0,0 +1,177 @@
+// Command classgen generates the embedded classification tables of the schema package
+// from the files published by the United Nations Statistics Division (UNSD):
+//
+//   - the CPC Ver. 2.1 structure with the titles of the sections, divisions, groups, classes
+//     and subclasses, e.g. CPC_Ver_2_1_english_structure.txt
+//   - the HS 2022 to CPC Ver. 2.1 correspondence, e.g. HS2022-CPC21.txt
+//
+// Usage:
+//
+//	go run ./internal/classgen -cpc CPC_Ver_2_1_english_structure.txt -cpc-output data/cpc21.csv
+//	go run ./internal/classgen -hs HS2022-CPC21.txt -hs-output data/cpc21-hs2022.csv
+//
+// The input files are CSV files with a header. The CPC structure has the code in its first column
+// and the title in its second one. The columns of the correspondence are found by their header,
+// the first one containing HS and the first one containing CPC.
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	cpcFormat = regexp.MustCompile(`^[0-9]{1,5}$`)
+	hsFormat  = regexp.MustCompile(`^[0-9]{6}$`)
+	clean     = strings.NewReplacer(" ", "", ".", "", "\uFEFF", "")
+)
+
+func main() {
+	cpcInput := flag.String("cpc", "", "the UNSD CPC Ver. 2.1 structure file")
+	cpcOutput := flag.String("cpc-output", "data/cpc21.csv", "the CPC table to generate")
+	hsInput := flag.String("hs", "", "the UNSD HS 2022 to CPC Ver. 2.1 correspondence file")
+	hsOutput := flag.String("hs-output", "data/cpc21-hs2022.csv", "the correspondence table to generate")
+	flag.Parse()
+
+	if *cpcInput == "" && *hsInput == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *cpcInput != "" {
+		if err := generate(*cpcInput, *cpcOutput, cpcTable); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *hsInput != "" {
+		if err := generate(*hsInput, *hsOutput, correspondenceTable); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate converts the records of the input file into the output table
+func generate(input, output string, convert func([][]string) ([][]string, error)) error {
+	file, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	records, err := readRecords(file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", input, err)
+	}
+	table, err := convert(records)
+	if err != nil {
+		return fmt.Errorf("%s: %w", input, err)
+	}
+
+	out, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	writer := csv.NewWriter(out)
+	if err := writer.WriteAll(table); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func readRecords(r io.Reader) ([][]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("no records")
+	}
+	return records, nil
+}
+
+// cpcTable returns the code and title of every CPC code, sorted by code
+func cpcTable(records [][]string) ([][]string, error) {
+	titles := map[string]string{}
+	for i, record := range records[1:] {
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: code and title expected", i+2)
+		}
+		code := clean.Replace(record[0])
+		if !cpcFormat.MatchString(code) {
+			return nil, fmt.Errorf("line %d: invalid CPC code %q", i+2, record[0])
+		}
+		titles[code] = strings.TrimSpace(record[1])
+	}
+
+	codes := make([]string, 0, len(titles))
+	for code := range titles {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	table := [][]string{{"code", "title"}}
+	for _, code := range codes {
+		table = append(table, []string{code, titles[code]})
+	}
+	return table, nil
+}
+
+// correspondenceTable returns the distinct pairs of CPC subclass and HS subheading, sorted
+func correspondenceTable(records [][]string) ([][]string, error) {
+	hsColumn, cpcColumn := -1, -1
+	for i, name := range records[0] {
+		name = strings.ToUpper(name)
+		if hsColumn < 0 && strings.Contains(name, "HS") {
+			hsColumn = i
+		}
+		if cpcColumn < 0 && strings.Contains(name, "CPC") {
+			cpcColumn = i
+		}
+	}
+	if hsColumn < 0 || cpcColumn < 0 {
+		return nil, fmt.Errorf("HS and CPC columns expected, header %v", records[0])
+	}
+
+	pairs := map[[2]string]bool{}
+	for i, record := range records[1:] {
+		if len(record) <= max(hsColumn, cpcColumn) {
+			return nil, fmt.Errorf("line %d: HS and CPC codes expected", i+2)
+		}
+		hs, cpc := clean.Replace(record[hsColumn]), clean.Replace(record[cpcColumn])
+		if !hsFormat.MatchString(hs) {
+			return nil, fmt.Errorf("line %d: invalid HS subheading %q", i+2, record[hsColumn])
+		}
+		if !cpcFormat.MatchString(cpc) || len(cpc) != 5 {
+			return nil, fmt.Errorf("line %d: invalid CPC subclass %q", i+2, record[cpcColumn])
+		}
+		pairs[[2]string{cpc, hs}] = true
+	}
+
+	sorted := make([][2]string, 0, len(pairs))
+	for pair := range pairs {
+		sorted = append(sorted, pair)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i][0] != sorted[j][0] {
+			return sorted[i][0] < sorted[j][0]
+		}
+		return sorted[i][1] < sorted[j][1]
+	})
+
+	table := [][]string{{"cpc", "hs"}}
+	for _, pair := range sorted {
+		table = append(table, []string{pair[0], pair[1]})
+	}
+	return table, nil
+}
@@ -0,0 +1,294 @@
+// Command enumgen generates the string enums of the schema package
+// from their declarative definition.
+//
+// Usage:
+//
+//	go run ./internal/enumgen -definitions enums.json -output enums_gen.go -test enums_gen_test.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// Enum is the definition of a string enum
+type Enum struct {
+
+	// The name of the Go type
+	Type string `json:"type"`
+
+	// The lines of the type doc comment
+	Doc []string `json:"doc"`
+
+	// The valid values of the enum, in order
+	Values []Value `json:"values"`
+}
+
+// Value is a valid value of an enum
+type Value struct {
+
+	// The name of the Go constant
+	Name string `json:"name"`
+
+	// The value as found in the JSON documents
+	Value string `json:"value"`
+
+	// The constant doc comment, lines separated by \n
+	Doc string `json:"doc"`
+}
+
+// Lookup returns the name of the unexported map of the enum values
+func (e Enum) Lookup() string {
+	runes := []rune(e.Type)
+	for i := 0; i < len(runes) && unicode.IsUpper(runes[i]); i++ {
+		// keep the last upper case letter of an acronym followed by a lower case one, e.g. PCROperator => pcrOperator
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes) + "Values"
+}
+
+var funcs = template.FuncMap{
+	"comment": func(doc string) string {
+		return "// " + strings.ReplaceAll(doc, "\n", "\n\t// ")
+	},
+}
+
+var source = template.Must(template.New("source").Funcs(funcs).Parse(`// Code generated by enumgen from {{.Definitions}}. DO NOT EDIT.
+
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+)
+{{range .Enums}}
+{{range .Doc}}// {{.}}
+{{end}}type {{.Type}} string
+
+// Error parsing the {{.Type}}
+var Err{{.Type}}Parse = errors.New("unsupported {{.Type}}")
+
+var {{.Lookup}} = map[string]{{.Type}}{
+{{- range .Values}}
+	{{printf "%q" .Value}}: {{.Name}},
+{{- end}}
+}
+
+{{$type := .Type -}}
+const (
+{{- range $i, $value := .Values}}
+{{- if $i}}
+{{end}}
+	{{comment $value.Doc}}
+	{{$value.Name}} {{$type}} = {{printf "%q" $value.Value}}
+{{- end}}
+)
+
+// Parse{{.Type}} returns the {{.Type}} with the given value,
+// or an *EnumParseError matching Err{{.Type}}Parse
+func Parse{{.Type}}(value string) ({{.Type}}, error) {
+	if parsed, ok := {{.Lookup}}[value]; !ok {
+		return "", newEnumParseError(Err{{.Type}}Parse, value, {{.Type}}("").Values())
+	} else {
+		return parsed, nil
+	}
+}
+
+// Values returns all the valid values of {{.Type}}
+func ({{.Type}}) Values() []{{.Type}} {
+	return []{{.Type}}{
+	{{- range .Values}}
+		{{.Name}},
+	{{- end}}
+	}
+}
+
+// IsValid reports whether the value is a valid {{.Type}}
+func (u {{.Type}}) IsValid() bool {
+	_, ok := {{.Lookup}}[string(u)]
+	return ok
+}
+
+func (u {{.Type}}) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u {{.Type}}) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		return nil, newEnumParseError(Err{{.Type}}Parse, string(u), u.Values())
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *{{.Type}}) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := Parse{{.Type}}(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u {{.Type}}) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *{{.Type}}) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+{{end}}`))
+
+var test = template.Must(template.New("test").Funcs(funcs).Parse(`// Code generated by enumgen from {{.Definitions}}. DO NOT EDIT.
+
+package schema
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+{{range .Enums}}
+func Test{{.Type}}Enum(t *testing.T) {
+
+	values := {{.Type}}("").Values()
+	assert.Equal(t, []{{.Type}}{
+	{{- range .Values}}
+		{{.Name}},
+	{{- end}}
+	}, values)
+
+	for _, value := range values {
+		assert.True(t, value.IsValid())
+
+		parsed, err := Parse{{.Type}}(value.String())
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsed)
+
+		data, err := json.Marshal(value)
+		assert.Nil(t, err)
+		assert.Equal(t, strconv.Quote(value.String()), string(data))
+
+		var decoded {{.Type}}
+		assert.Nil(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded)
+
+		text, err := value.MarshalText()
+		assert.Nil(t, err)
+
+		var unmarshalled {{.Type}}
+		assert.Nil(t, unmarshalled.UnmarshalText(text))
+		assert.Equal(t, value, unmarshalled)
+	}
+
+	invalid := {{.Type}}("unsupported {{.Type}}")
+	assert.False(t, invalid.IsValid())
+
+	_, err := json.Marshal(invalid)
+	assert.ErrorIs(t, err, Err{{.Type}}Parse)
+
+	var decoded {{.Type}}
+	err = json.Unmarshal([]byte(strconv.Quote(invalid.String())), &decoded)
+	assert.ErrorIs(t, err, Err{{.Type}}Parse)
+
+	var parseErr *EnumParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "{{.Type}}", parseErr.Type)
+		assert.Equal(t, invalid.String(), parseErr.Value)
+		assert.Len(t, parseErr.Allowed, len(values))
+	}
+
+	err = json.Unmarshal([]byte("1"), &decoded)
+	assert.Error(t, err)
+
+	data, err := json.Marshal({{.Type}}(""))
+	assert.Nil(t, err)
+	assert.Equal(t, ` + "`\"\"`" + `, string(data))
+
+	for _, zero := range []string{` + "`\"\"`" + `, "null"} {
+		decoded = values[0]
+		assert.Nil(t, json.Unmarshal([]byte(zero), &decoded), zero)
+		assert.Equal(t, {{.Type}}(""), decoded, zero)
+	}
+
+}
+{{end}}`))
+
+func generate(tmpl *template.Template, data any, output string) error {
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return err
+	}
+
+	formatted, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", output, err)
+	}
+
+	return os.WriteFile(output, formatted, 0o644)
+}
+
+func main() {
+	definitions := flag.String("definitions", "enums.json", "the enum definitions")
+	output := flag.String("output", "enums_gen.go", "the generated source file")
+	testOutput := flag.String("test", "enums_gen_test.go", "the generated test file")
+	flag.Parse()
+
+	content, err := os.ReadFile(*definitions)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var enums []Enum
+	if err := json.Unmarshal(content, &enums); err != nil {
+		log.Fatalf("parsing %s: %v", *definitions, err)
+	}
+
+	data := struct {
+		Definitions string
+		Enums       []Enum
+	}{*definitions, enums}
+
+	if err := generate(source, data, *output); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := generate(test, data, *testOutput); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,243 @@
+package schema
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Error parsing the ISO3166CC
+var ErrISO3166CCParse = errors.New("unsupported ISO3166CC")
+
+// Error parsing the ISO3166Subdivision
+var ErrISO3166SubdivisionParse = errors.New("unsupported ISO3166Subdivision")
+
+// Error when the subdivision of a CarbonFootprint is not part of its country
+var ErrSubdivisionCountryMismatch = errors.New("geographyCountrySubdivision is not part of geographyCountry")
+
+// Error when more than one geography is specified for a CarbonFootprint
+var ErrGeographyMultiple = errors.New("at most one of geographyCountrySubdivision, geographyCountry and geographyRegionOrSubregion can be defined")
+
+// The ISO 3166-2 subdivision codes of every country
+//
+//go:embed data/iso3166-2.txt
+var iso3166SubdivisionsTxt string
+
+var subdivisions = loadISO3166Subdivisions()
+
+func loadISO3166Subdivisions() map[string]bool {
+	codes := map[string]bool{}
+	for _, line := range strings.Split(iso3166SubdivisionsTxt, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		codes[line] = true
+	}
+	return codes
+}
+
+// ISO3166CC is an ISO 3166-1 alpha-2 country code, e.g. FR
+type ISO3166CC string
+
+// ParseISO3166CC returns the ISO3166CC with the given value,
+// or an *EnumParseError matching ErrISO3166CCParse
+func ParseISO3166CC(value string) (ISO3166CC, error) {
+	if _, ok := countries[value]; !ok {
+		err := &EnumParseError{Type: "ISO3166CC", Value: value, sentinel: ErrISO3166CCParse}
+		if _, ok := countries[strings.ToUpper(strings.TrimSpace(value))]; ok {
+			err.Suggestion = strings.ToUpper(strings.TrimSpace(value))
+		}
+		return "", err
+	}
+	return ISO3166CC(value), nil
+}
+
+// IsValid reports whether the value is an assigned ISO 3166-1 alpha-2 country code
+func (u ISO3166CC) IsValid() bool {
+	_, ok := countries[string(u)]
+	return ok
+}
+
+// Name returns the short name of the country
+func (u ISO3166CC) Name() string {
+	return countries[string(u)].name
+}
+
+func (u ISO3166CC) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u ISO3166CC) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		_, err := ParseISO3166CC(string(u))
+		return nil, err
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *ISO3166CC) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseISO3166CC(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u ISO3166CC) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *ISO3166CC) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+// ISO3166Subdivision is an ISO 3166-2 subdivision code, e.g. US-NY or FR-89
+type ISO3166Subdivision string
+
+// ParseISO3166Subdivision returns the ISO3166Subdivision with the given value,
+// or an *EnumParseError matching ErrISO3166SubdivisionParse
+func ParseISO3166Subdivision(value string) (ISO3166Subdivision, error) {
+	if !ISO3166Subdivision(value).IsValid() {
+		err := &EnumParseError{Type: "ISO3166Subdivision", Value: value, sentinel: ErrISO3166SubdivisionParse}
+		if suggestion := strings.ToUpper(strings.TrimSpace(value)); ISO3166Subdivision(suggestion).IsValid() {
+			err.Suggestion = suggestion
+		}
+		return "", err
+	}
+	return ISO3166Subdivision(value), nil
+}
+
+// IsValid reports whether the value is listed in the embedded ISO 3166-2 subdivision table
+func (u ISO3166Subdivision) IsValid() bool {
+	return subdivisions[string(u)] && u.Country().IsValid()
+}
+
+// Country returns the country of the subdivision
+func (u ISO3166Subdivision) Country() ISO3166CC {
+	country, _, _ := strings.Cut(string(u), "-")
+	return ISO3166CC(country)
+}
+
+func (u ISO3166Subdivision) String() string {
+	return string(u)
+}
+
+// MarshalText fails for invalid values, the zero value is marshalled as the empty string
+func (u ISO3166Subdivision) MarshalText() ([]byte, error) {
+	if u != "" && !u.IsValid() {
+		_, err := ParseISO3166Subdivision(string(u))
+		return nil, err
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText fails for invalid values, the empty string is unmarshalled as the zero value
+func (u *ISO3166Subdivision) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*u = ""
+		return nil
+	}
+
+	value, err := ParseISO3166Subdivision(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = value
+	return nil
+}
+
+func (u ISO3166Subdivision) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals null like the empty string, as the zero value
+func (u *ISO3166Subdivision) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+// ValidateGeography checks the geographic scope of the CarbonFootprint
+// (see § 4.2.1 Scope of a CarbonFootprint): the codes must be valid, the subdivision
+// must be part of the country, and at most one of geographyCountrySubdivision,
+// geographyCountry and geographyRegionOrSubregion can be defined.
+func (c CarbonFootprint) ValidateGeography() error {
+	var errs []error
+
+	if c.GeographyCountrySubdivision != "" && !c.GeographyCountrySubdivision.IsValid() {
+		_, err := ParseISO3166Subdivision(c.GeographyCountrySubdivision.String())
+		errs = append(errs, err)
+	}
+
+	if c.GeographyCountry != "" && !c.GeographyCountry.IsValid() {
+		_, err := ParseISO3166CC(c.GeographyCountry.String())
+		errs = append(errs, err)
+	}
+
+	if c.GeographyRegionOrSubregion != "" && !c.GeographyRegionOrSubregion.IsValid() {
+		_, err := ParseRegionOrSubregion(c.GeographyRegionOrSubregion.String())
+		errs = append(errs, err)
+	}
+
+	if c.GeographyCountrySubdivision != "" && c.GeographyCountry != "" &&
+		c.GeographyCountrySubdivision.Country() != c.GeographyCountry {
+		errs = append(errs, fmt.Errorf("%w: %s is not in %s",
+			ErrSubdivisionCountryMismatch, c.GeographyCountrySubdivision, c.GeographyCountry))
+	}
+
+	defined := 0
+	for _, geography := range []string{
+		string(c.GeographyCountrySubdivision),
+		string(c.GeographyCountry),
+		string(c.GeographyRegionOrSubregion),
+	} {
+		if geography != "" {
+			defined++
+		}
+	}
+	if defined > 1 {
+		errs = append(errs, ErrGeographyMultiple)
+	}
+
+	return errors.Join(errs...)
+}
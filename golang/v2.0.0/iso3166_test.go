@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestISO3166CC(t *testing.T) {
+
+	var country ISO3166CC
+	assert.Nil(t, json.Unmarshal([]byte(`"FR"`), &country))
+	assert.Equal(t, "France", country.Name())
+
+	err := json.Unmarshal([]byte(`"fr"`), &country)
+	assert.ErrorIs(t, err, ErrISO3166CCParse)
+	assert.EqualError(t, err, `unsupported ISO3166CC "fr", did you mean "FR"?`)
+
+	err = json.Unmarshal([]byte(`"XX"`), &country)
+	assert.EqualError(t, err, `unsupported ISO3166CC "XX"`)
+
+}
+
+func TestISO3166ZeroValue(t *testing.T) {
+
+	for _, data := range []string{
+		`{"pcf": {"geographyCountry": null, "geographyCountrySubdivision": null}}`,
+		`{"pcf": {"geographyCountry": "", "geographyCountrySubdivision": ""}}`,
+	} {
+		product := ProductFootprint{Pcf: CarbonFootprint{GeographyCountry: "FR", GeographyCountrySubdivision: "FR-89"}}
+		assert.Nil(t, json.Unmarshal([]byte(data), &product), data)
+		assert.Equal(t, ISO3166CC(""), product.Pcf.GeographyCountry, data)
+		assert.Equal(t, ISO3166Subdivision(""), product.Pcf.GeographyCountrySubdivision, data)
+	}
+
+	data, err := json.Marshal(struct {
+		Country     ISO3166CC
+		Subdivision ISO3166Subdivision
+	}{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Country":"","Subdivision":""}`, string(data))
+
+	// a footprint without optional codes round-trips
+	data, err = json.Marshal(ProductFootprint{})
+	assert.Nil(t, err)
+	var product ProductFootprint
+	assert.Nil(t, json.Unmarshal(data, &product))
+	encoded, err := json.Marshal(product)
+	assert.Nil(t, err)
+	assert.JSONEq(t, string(data), string(encoded))
+
+}
+
+func TestISO3166Subdivision(t *testing.T) {
+
+	for _, code := range []string{"US-NY", "FR-89", "FR-2A", "DE-BY", "JP-13", "GB-LND", "IT-MI", "BE-VAN", "KE-30"} {
+		subdivision, err := ParseISO3166Subdivision(code)
+		assert.Nil(t, err, code)
+		assert.Equal(t, ISO3166CC(code[:2]), subdivision.Country())
+	}
+
+	// well-formed codes are rejected for every country when they are not assigned
+	for _, code := range []string{"US-XX", "XX-NY", "US", "us-ny", "FR-8900", "GB-XYZ", "IT-ZZ", "BE-X"} {
+		_, err := ParseISO3166Subdivision(code)
+		assert.ErrorIs(t, err, ErrISO3166SubdivisionParse, code)
+	}
+
+	for code := range subdivisions {
+		assert.True(t, ISO3166Subdivision(code).IsValid(), code)
+	}
+
+}
+
+func TestValidateGeography(t *testing.T) {
+
+	assert.Nil(t, CarbonFootprint{}.ValidateGeography())
+	assert.Nil(t, CarbonFootprint{GeographyCountrySubdivision: "US-NY"}.ValidateGeography())
+	assert.Nil(t, CarbonFootprint{GeographyRegionOrSubregion: WesternEurope}.ValidateGeography())
+
+	err := CarbonFootprint{GeographyCountrySubdivision: "US-NY", GeographyCountry: "FR"}.ValidateGeography()
+	assert.ErrorIs(t, err, ErrSubdivisionCountryMismatch)
+	assert.ErrorIs(t, err, ErrGeographyMultiple)
+
+	err = CarbonFootprint{GeographyCountry: "FR", GeographyRegionOrSubregion: WesternEurope}.ValidateGeography()
+	assert.ErrorIs(t, err, ErrGeographyMultiple)
+	assert.NotErrorIs(t, err, ErrSubdivisionCountryMismatch)
+
+	err = CarbonFootprint{GeographyCountry: "XX"}.ValidateGeography()
+	assert.ErrorIs(t, err, ErrISO3166CCParse)
+
+}
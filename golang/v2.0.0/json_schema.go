@@ -0,0 +1,367 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SchemaViolation is a value not valid against its JSON schema.
+// It matches ErrSchemaViolation with errors.Is.
+type SchemaViolation struct {
+
+	// The path of the value, e.g. extensions[0].data.weight
+	Path string
+
+	// The reason the value is not valid
+	Message string
+}
+
+func (e *SchemaViolation) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+func (e *SchemaViolation) Unwrap() error {
+	return ErrSchemaViolation
+}
+
+// schemaValidator validates decoded JSON documents against a JSON schema.
+// It supports the keywords used by the extension schemas: type, enum, const,
+// properties, required, additionalProperties, items, minItems, maxItems,
+// minLength, maxLength, pattern, format (date-time, date, uri, uuid),
+// minimum, maximum, exclusiveMinimum, exclusiveMaximum, multipleOf,
+// allOf, anyOf, oneOf, not, and $ref to the definitions of the same schema.
+type schemaValidator struct {
+	root       any
+	violations []error
+	patterns   map[string]*regexp.Regexp
+}
+
+func (v *schemaValidator) violation(path, format string, args ...any) {
+	v.violations = append(v.violations, &SchemaViolation{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// valid reports whether the value is valid against the schema, without recording violations
+func (v *schemaValidator) valid(schema, value any, path string) bool {
+	nested := &schemaValidator{root: v.root, patterns: v.patterns}
+	nested.validate(schema, value, path)
+	return len(nested.violations) == 0
+}
+
+func (v *schemaValidator) validate(schema, value any, path string) {
+	switch s := schema.(type) {
+	case bool:
+		if !s {
+			v.violation(path, "not allowed")
+		}
+		return
+	case map[string]any:
+		v.keywords(s, value, path)
+	}
+}
+
+func (v *schemaValidator) keywords(schema map[string]any, value any, path string) {
+	if ref, ok := schema["$ref"].(string); ok {
+		target, err := v.resolve(ref)
+		if err != nil {
+			v.violation(path, "%s", err)
+			return
+		}
+		v.validate(target, value, path)
+	}
+
+	if expected, ok := schema["type"]; ok && !matchesType(expected, value) {
+		v.violation(path, "expected %s, got %s", typeNames(expected), jsonType(value))
+		return
+	}
+
+	if values, ok := schema["enum"].([]any); ok {
+		found := false
+		for _, allowed := range values {
+			if jsonEqual(allowed, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.violation(path, "%s is not one of the allowed values", jsonText(value))
+		}
+	}
+
+	if expected, ok := schema["const"]; ok && !jsonEqual(expected, value) {
+		v.violation(path, "expected %s", jsonText(expected))
+	}
+
+	switch typed := value.(type) {
+	case string:
+		v.string(schema, typed, path)
+	case json.Number:
+		v.number(schema, typed, path)
+	case map[string]any:
+		v.object(schema, typed, path)
+	case []any:
+		v.array(schema, typed, path)
+	}
+
+	if all, ok := schema["allOf"].([]any); ok {
+		for _, subschema := range all {
+			v.validate(subschema, value, path)
+		}
+	}
+
+	if anyOf, ok := schema["anyOf"].([]any); ok {
+		matched := false
+		for _, subschema := range anyOf {
+			if v.valid(subschema, value, path) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			v.violation(path, "does not match any of the allowed schemas")
+		}
+	}
+
+	if oneOf, ok := schema["oneOf"].([]any); ok {
+		matches := 0
+		for _, subschema := range oneOf {
+			if v.valid(subschema, value, path) {
+				matches++
+			}
+		}
+		if matches != 1 {
+			v.violation(path, "matches %d of the schemas instead of exactly one", matches)
+		}
+	}
+
+	if not, ok := schema["not"]; ok && v.valid(not, value, path) {
+		v.violation(path, "matches a schema it must not match")
+	}
+}
+
+func (v *schemaValidator) string(schema map[string]any, value, path string) {
+	length := utf8.RuneCountInString(value)
+	if minimum, ok := schemaInt(schema, "minLength"); ok && length < minimum {
+		v.violation(path, "shorter than %d characters", minimum)
+	}
+	if maximum, ok := schemaInt(schema, "maxLength"); ok && length > maximum {
+		v.violation(path, "longer than %d characters", maximum)
+	}
+
+	if pattern, ok := schema["pattern"].(string); ok {
+		expression, ok := v.patterns[pattern]
+		if !ok {
+			var err error
+			if expression, err = regexp.Compile(pattern); err != nil {
+				v.violation(path, "invalid pattern %q", pattern)
+				return
+			}
+			v.patterns[pattern] = expression
+		}
+		if !expression.MatchString(value) {
+			v.violation(path, "does not match the pattern %q", pattern)
+		}
+	}
+
+	if format, ok := schema["format"].(string); ok && !validFormat(format, value) {
+		v.violation(path, "not a valid %s", format)
+	}
+}
+
+func validFormat(format, value string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	case "date":
+		_, err := time.Parse(time.DateOnly, value)
+		return err == nil
+	case "uri":
+		u, err := url.Parse(value)
+		return err == nil && u.Scheme != ""
+	case "uuid":
+		_, err := uuid.Parse(value)
+		return err == nil && len(value) == 36
+	}
+	return true
+}
+
+func (v *schemaValidator) number(schema map[string]any, value json.Number, path string) {
+	number, err := decimal.NewFromString(value.String())
+	if err != nil {
+		v.violation(path, "not a number")
+		return
+	}
+
+	if minimum, ok := schemaNumber(schema, "minimum"); ok && number.LessThan(minimum) {
+		v.violation(path, "less than %s", minimum)
+	}
+	if maximum, ok := schemaNumber(schema, "maximum"); ok && number.GreaterThan(maximum) {
+		v.violation(path, "greater than %s", maximum)
+	}
+	if minimum, ok := schemaNumber(schema, "exclusiveMinimum"); ok && number.LessThanOrEqual(minimum) {
+		v.violation(path, "less than or equal to %s", minimum)
+	}
+	if maximum, ok := schemaNumber(schema, "exclusiveMaximum"); ok && number.GreaterThanOrEqual(maximum) {
+		v.violation(path, "greater than or equal to %s", maximum)
+	}
+	if multiple, ok := schemaNumber(schema, "multipleOf"); ok && !multiple.IsZero() && !number.Mod(multiple).IsZero() {
+		v.violation(path, "not a multiple of %s", multiple)
+	}
+}
+
+func (v *schemaValidator) object(schema map[string]any, value map[string]any, path string) {
+	if required, ok := schema["required"].([]any); ok {
+		for _, name := range required {
+			if key, ok := name.(string); ok {
+				if _, ok := value[key]; !ok {
+					v.violation(joinPath(path, key), "required")
+				}
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	additional, hasAdditional := schema["additionalProperties"]
+	for _, key := range sortedKeys(value) {
+		if property, ok := properties[key]; ok {
+			v.validate(property, value[key], joinPath(path, key))
+		} else if hasAdditional {
+			if allowed, ok := additional.(bool); ok && !allowed {
+				v.violation(joinPath(path, key), "additional property not allowed")
+			} else {
+				v.validate(additional, value[key], joinPath(path, key))
+			}
+		}
+	}
+}
+
+func (v *schemaValidator) array(schema map[string]any, value []any, path string) {
+	if minimum, ok := schemaInt(schema, "minItems"); ok && len(value) < minimum {
+		v.violation(path, "fewer than %d items", minimum)
+	}
+	if maximum, ok := schemaInt(schema, "maxItems"); ok && len(value) > maximum {
+		v.violation(path, "more than %d items", maximum)
+	}
+
+	if items, ok := schema["items"]; ok {
+		for i, item := range value {
+			v.validate(items, item, fmt.Sprintf("%s[%d]", path, i))
+		}
+	}
+}
+
+// resolve returns the subschema of the root schema referenced by a JSON pointer, e.g. #/$defs/Weight
+func (v *schemaValidator) resolve(ref string) (any, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("unsupported $ref %q", ref)
+	}
+
+	target := v.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		object, ok := target.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unresolved $ref %q", ref)
+		}
+		if target, ok = object[token]; !ok {
+			return nil, fmt.Errorf("unresolved $ref %q", ref)
+		}
+	}
+	return target, nil
+}
+
+func matchesType(expected, value any) bool {
+	switch typed := expected.(type) {
+	case string:
+		actual := jsonType(value)
+		if typed == "number" && actual == "integer" {
+			return true
+		}
+		return typed == actual
+	case []any:
+		for _, name := range typed {
+			if matchesType(name, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func typeNames(expected any) string {
+	if names, ok := expected.([]any); ok {
+		parts := make([]string, len(names))
+		for i, name := range names {
+			parts[i] = fmt.Sprint(name)
+		}
+		return strings.Join(parts, " or ")
+	}
+	return fmt.Sprint(expected)
+}
+
+// jsonType returns the JSON schema type of a decoded JSON value
+func jsonType(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if number, err := decimal.NewFromString(typed.String()); err == nil && number.IsInteger() {
+			return "integer"
+		}
+		return "number"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func jsonText(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// jsonEqual reports whether two decoded JSON values are equal, numbers being compared numerically
+func jsonEqual(a, b any) bool {
+	var d Diff
+	d.json("", a, b)
+	return len(d) == 0
+}
+
+func schemaInt(schema map[string]any, keyword string) (int, bool) {
+	number, ok := schema[keyword].(json.Number)
+	if !ok {
+		return 0, false
+	}
+	value, err := strconv.Atoi(number.String())
+	return value, err == nil
+}
+
+func schemaNumber(schema map[string]any, keyword string) (decimal.Decimal, bool) {
+	number, ok := schema[keyword].(json.Number)
+	if !ok {
+		return decimal.Zero, false
+	}
+	value, err := decimal.NewFromString(number.String())
+	return value, err == nil
+}
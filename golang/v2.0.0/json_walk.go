@@ -0,0 +1,175 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// jsonObject is a decoded JSON object keeping its members in document order
+type jsonObject []jsonMember
+
+type jsonMember struct {
+	Name  string
+	Value any
+}
+
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	buffer.WriteByte('{')
+	for i, member := range o {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		if err := encoder.Encode(member.Name); err != nil {
+			return nil, err
+		}
+		buffer.WriteByte(':')
+		if err := encoder.Encode(member.Value); err != nil {
+			return nil, err
+		}
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// decodeOrderedJSON decodes a JSON document like decodeJSON,
+// but with its objects decoded as jsonObject to keep the document order
+func decodeOrderedJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	document, err := decodeOrderedValue(decoder)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("invalid data after top-level value")
+	}
+	return document, nil
+}
+
+func decodeOrderedValue(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		object := jsonObject{}
+		for decoder.More() {
+			name, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrderedValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object = append(object, jsonMember{Name: name.(string), Value: value})
+		}
+		_, err = decoder.Token()
+		return object, err
+
+	case json.Delim('['):
+		array := []any{}
+		for decoder.More() {
+			value, err := decodeOrderedValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err = decoder.Token()
+		return array, err
+	}
+	return token, nil
+}
+
+// walkJSONStrings walks a JSON document decoded with decodeOrderedJSON along the Go type it is
+// decoded into, and calls visit with the path and Go type of every string value and map key,
+// in document order.
+// The string is replaced by the value returned by visit, and the walk stops when visit returns false.
+// It returns the updated document and whether the walk completed.
+func walkJSONStrings(document any, t reflect.Type, path string, visit func(path string, t reflect.Type, value string) (string, bool)) (any, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch value := document.(type) {
+	case string:
+		return visit(path, t, value)
+
+	case []any:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return document, true
+		}
+		for i, element := range value {
+			updated, ok := walkJSONStrings(element, t.Elem(), fmt.Sprintf("%s[%d]", path, i), visit)
+			value[i] = updated
+			if !ok {
+				return value, false
+			}
+		}
+
+	case jsonObject:
+		switch t.Kind() {
+		case reflect.Struct:
+			for i, member := range value {
+				field, ok := jsonField(t, member.Name)
+				if !ok {
+					continue
+				}
+				updated, ok := walkJSONStrings(member.Value, field.Type, joinPath(path, member.Name), visit)
+				value[i].Value = updated
+				if !ok {
+					return value, false
+				}
+			}
+
+		case reflect.Map:
+			for i, member := range value {
+				updatedName, ok := visit(joinPath(path, member.Name), t.Key(), member.Name)
+				value[i].Name = updatedName
+				if !ok {
+					return value, false
+				}
+
+				updated, ok := walkJSONStrings(member.Value, t.Elem(), joinPath(path, updatedName), visit)
+				value[i].Value = updated
+				if !ok {
+					return value, false
+				}
+			}
+		}
+	}
+
+	return document, true
+}
+
+// jsonField returns the field of the struct decoded from the JSON key,
+// matching the names case-insensitively like encoding/json
+func jsonField(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if strings.EqualFold(name, key) {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,258 @@
+package schema
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Error when a key is neither a valid Ed25519 key nor an ECDSA P-256 key
+var ErrUnsupportedKey = errors.New("unsupported key, Ed25519 or ECDSA P-256 expected")
+
+// Error when a JWS is not a detached compact JWS
+var ErrJWSFormat = errors.New("invalid detached JWS")
+
+// Error when the key id of a JWS is not part of the KeySet
+var ErrUnknownKeyID = errors.New("unknown key id")
+
+// Error when a JWS does not match the footprint or the key
+var ErrSignatureInvalid = errors.New("invalid signature")
+
+// The JWS algorithms supported
+const (
+	EdDSA = "EdDSA"
+	ES256 = "ES256"
+)
+
+// jwsHeader is the protected header of a JWS
+type jwsHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+// keyAlgorithm returns the JWS algorithm of a public key
+func keyAlgorithm(key crypto.PublicKey) (string, error) {
+	switch typed := key.(type) {
+	case ed25519.PublicKey:
+		if len(typed) == ed25519.PublicKeySize {
+			return EdDSA, nil
+		}
+	case *ecdsa.PublicKey:
+		if typed != nil && typed.Curve == elliptic.P256() {
+			return ES256, nil
+		}
+	}
+	return "", ErrUnsupportedKey
+}
+
+// Signer signs ProductFootprints with a private key identified by its key id
+type Signer struct {
+	keyID     string
+	key       crypto.Signer
+	algorithm string
+}
+
+// NewSigner returns a Signer with a key whose public key is an Ed25519 key or an ECDSA key
+// on the P-256 curve, e.g. an ed25519.PrivateKey, an *ecdsa.PrivateKey or a key held by an HSM.
+// The key signs the message for Ed25519, and its SHA-256 digest with an ASN.1 signature for ECDSA.
+// The key id is embedded in the JWS header to let the recipient select the public key.
+func NewSigner(keyID string, key crypto.Signer) (*Signer, error) {
+	switch typed := key.(type) {
+	case nil:
+		return nil, ErrUnsupportedKey
+	case ed25519.PrivateKey:
+		if len(typed) != ed25519.PrivateKeySize {
+			return nil, fmt.Errorf("%w: private key has %d bytes", ErrUnsupportedKey, len(typed))
+		}
+	case *ecdsa.PrivateKey:
+		if typed == nil {
+			return nil, ErrUnsupportedKey
+		}
+	}
+	algorithm, err := keyAlgorithm(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{keyID: keyID, key: key, algorithm: algorithm}, nil
+}
+
+// SignPayload returns the detached JWS (RFC 7515 Appendix F) of the canonical form
+// of the JSON payload: the protected header and the signature separated by two dots
+func (s *Signer) SignPayload(payload []byte) (string, error) {
+	canonical, err := CanonicalJSON(payload)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(jwsHeader{Algorithm: s.algorithm, KeyID: s.keyID})
+	if err != nil {
+		return "", err
+	}
+	encodedHeader := base64.RawURLEncoding.EncodeToString(header)
+	input := encodedHeader + "." + base64.RawURLEncoding.EncodeToString(canonical)
+
+	var signature []byte
+	switch s.algorithm {
+	case EdDSA:
+		signature, err = s.key.Sign(rand.Reader, []byte(input), crypto.Hash(0))
+		if err != nil {
+			return "", err
+		}
+	case ES256:
+		digest := sha256.Sum256([]byte(input))
+		der, err := s.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+		if err != nil {
+			return "", err
+		}
+		var values struct{ R, S *big.Int }
+		if rest, err := asn1.Unmarshal(der, &values); err != nil || len(rest) > 0 {
+			return "", fmt.Errorf("%w: invalid ECDSA signature", ErrSignatureInvalid)
+		}
+		signature = make([]byte, 64)
+		values.R.FillBytes(signature[:32])
+		values.S.FillBytes(signature[32:])
+	default:
+		return "", ErrUnsupportedKey
+	}
+
+	return encodedHeader + ".." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Sign returns the detached JWS of the canonical form of the ProductFootprint
+func (s *Signer) Sign(p ProductFootprint) (string, error) {
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return s.SignPayload(payload)
+}
+
+// SignedFootprints is a list response with the detached JWS of every footprint
+type SignedFootprints struct {
+
+	// The footprints
+	Data []ProductFootprint `json:"data"`
+
+	// The detached JWS of the footprint with the same index
+	Signatures []string `json:"signatures"`
+}
+
+// SignList returns the list response of the footprints with their detached JWS
+func (s *Signer) SignList(footprints []ProductFootprint) (SignedFootprints, error) {
+	list := SignedFootprints{Data: footprints, Signatures: make([]string, len(footprints))}
+	for i, footprint := range footprints {
+		signature, err := s.Sign(footprint)
+		if err != nil {
+			return SignedFootprints{}, fmt.Errorf("data[%d]: %w", i, err)
+		}
+		list.Signatures[i] = signature
+	}
+	return list, nil
+}
+
+// KeySet is a local set of public keys keyed by their key id, used to verify signatures
+type KeySet map[string]crypto.PublicKey
+
+// Add adds an ed25519.PublicKey or an *ecdsa.PublicKey on the P-256 curve to the set
+func (k KeySet) Add(keyID string, key crypto.PublicKey) error {
+	if _, err := keyAlgorithm(key); err != nil {
+		return err
+	}
+	k[keyID] = key
+	return nil
+}
+
+// VerifyPayload verifies the detached JWS against the canonical form of the JSON payload
+// and returns the key id of the signer
+func (k KeySet) VerifyPayload(payload []byte, jws string) (string, error) {
+	encodedHeader, encodedSignature, ok := strings.Cut(jws, "..")
+	if !ok || strings.Contains(encodedSignature, ".") {
+		return "", ErrJWSFormat
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encodedHeader)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrJWSFormat, err)
+	}
+	var header jwsHeader
+	if err := json.Unmarshal(data, &header); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrJWSFormat, err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrJWSFormat, err)
+	}
+
+	key, ok := k[header.KeyID]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownKeyID, header.KeyID)
+	}
+	// the key is checked again as it may have been set without Add
+	algorithm, err := keyAlgorithm(key)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", err, header.KeyID)
+	}
+	if algorithm != header.Algorithm {
+		return "", fmt.Errorf("%w: algorithm %s for a %s key", ErrSignatureInvalid, header.Algorithm, algorithm)
+	}
+
+	canonical, err := CanonicalJSON(payload)
+	if err != nil {
+		return "", err
+	}
+	input := []byte(encodedHeader + "." + base64.RawURLEncoding.EncodeToString(canonical))
+
+	valid := false
+	switch key := key.(type) {
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, input, signature)
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(input)
+		valid = len(signature) == 64 && ecdsa.Verify(key, digest[:],
+			new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:]))
+	}
+	if !valid {
+		return "", ErrSignatureInvalid
+	}
+
+	return header.KeyID, nil
+}
+
+// Verify verifies the detached JWS against the canonical form of the ProductFootprint
+// and returns the key id of the signer
+func (k KeySet) Verify(p ProductFootprint, jws string) (string, error) {
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return k.VerifyPayload(payload, jws)
+}
+
+// VerifyList verifies the signature of every footprint of the list response,
+// reporting the footprints with a missing or invalid signature and the signatures in excess
+func (k KeySet) VerifyList(list SignedFootprints) error {
+	var errs []error
+	if len(list.Signatures) > len(list.Data) {
+		errs = append(errs, fmt.Errorf("signatures: %w: %d signatures for %d footprints", ErrSignatureInvalid, len(list.Signatures), len(list.Data)))
+	}
+	for i, footprint := range list.Data {
+		if i >= len(list.Signatures) {
+			errs = append(errs, fmt.Errorf("data[%d]: %w: missing signature", i, ErrSignatureInvalid))
+			continue
+		}
+		if _, err := k.Verify(footprint, list.Signatures[i]); err != nil {
+			errs = append(errs, fmt.Errorf("data[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
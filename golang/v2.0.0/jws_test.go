@@ -0,0 +1,199 @@
+package schema
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// testFootprint returns a footprint with every mandatory enumeration set
+func testFootprint() ProductFootprint {
+	return ProductFootprint{
+		Id:                 uuid.New(),
+		Version:            1,
+		Created:            time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Status:             Active,
+		ProductDescription: "Cote'd Or Ethanol",
+		ProductCategoryCpc: "3342",
+		Pcf: CarbonFootprint{
+			DeclaredUnit:               Liter,
+			PCfExcludingBiogenic:       decimal.RequireFromString("1.50"),
+			CharacterizationFactors:    AR6,
+			GeographyRegionOrSubregion: WesternEurope,
+		},
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	ecPrivate, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	otherPrivate, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	assert.Nil(t, err)
+
+	keys := KeySet{}
+	assert.Nil(t, keys.Add("ed", edPublic))
+	assert.Nil(t, keys.Add("ec", &ecPrivate.PublicKey))
+	assert.ErrorIs(t, keys.Add("p384", &otherPrivate.PublicKey), ErrUnsupportedKey)
+
+	_, err = NewSigner("p384", otherPrivate)
+	assert.ErrorIs(t, err, ErrUnsupportedKey)
+
+	footprint := testFootprint()
+
+	for keyID, key := range map[string]crypto.Signer{"ed": edPrivate, "ec": ecPrivate} {
+		signer, err := NewSigner(keyID, key)
+		assert.Nil(t, err)
+
+		jws, err := signer.Sign(footprint)
+		assert.Nil(t, err)
+
+		signed, err := keys.Verify(footprint, jws)
+		assert.Nil(t, err, keyID)
+		assert.Equal(t, keyID, signed)
+
+		data, err := json.MarshalIndent(footprint, "", "  ")
+		assert.Nil(t, err)
+		var received ProductFootprint
+		assert.Nil(t, json.Unmarshal(data, &received))
+		_, err = keys.Verify(received, jws)
+		assert.Nil(t, err, keyID)
+		_, err = keys.VerifyPayload(data, jws)
+		assert.Nil(t, err, keyID)
+
+		tampered := footprint
+		tampered.Pcf.PCfExcludingBiogenic = decimal.RequireFromString("1.4")
+		_, err = keys.Verify(tampered, jws)
+		assert.ErrorIs(t, err, ErrSignatureInvalid, keyID)
+	}
+
+	signer, err := NewSigner("unknown", edPrivate)
+	assert.Nil(t, err)
+	jws, err := signer.Sign(footprint)
+	assert.Nil(t, err)
+	_, err = keys.Verify(footprint, jws)
+	assert.ErrorIs(t, err, ErrUnknownKeyID)
+
+	_, err = keys.Verify(footprint, "header.payload.signature")
+	assert.ErrorIs(t, err, ErrJWSFormat)
+
+	// data appended to the payload or duplicate members are rejected
+	signer, err = NewSigner("ed", edPrivate)
+	assert.Nil(t, err)
+	jws, err = signer.SignPayload([]byte(`{"pcf":1}`))
+	assert.Nil(t, err)
+	_, err = keys.VerifyPayload([]byte(`{"pcf":1}`), jws)
+	assert.Nil(t, err)
+	for _, payload := range []string{`{"pcf":1}{"pcf":999}`, `{"pcf":999,"pcf":1}`} {
+		_, err = keys.VerifyPayload([]byte(payload), jws)
+		assert.ErrorIs(t, err, ErrCanonicalization, payload)
+	}
+
+}
+
+func TestSignVerifyList(t *testing.T) {
+
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	signer, err := NewSigner("ed", private)
+	assert.Nil(t, err)
+
+	keys := KeySet{}
+	assert.Nil(t, keys.Add("ed", private.Public()))
+
+	second := testFootprint()
+	second.Id = uuid.New()
+
+	list, err := signer.SignList([]ProductFootprint{testFootprint(), second})
+	assert.Nil(t, err)
+
+	data, err := json.Marshal(list)
+	assert.Nil(t, err)
+	var received SignedFootprints
+	assert.Nil(t, json.Unmarshal(data, &received))
+	assert.Nil(t, keys.VerifyList(received))
+
+	received.Data[1].Version = 2
+	received.Signatures = received.Signatures[:1]
+	received.Data = append(received.Data, ProductFootprint{})
+	assert.EqualError(t, keys.VerifyList(received), "data[1]: invalid signature: missing signature\n"+
+		"data[2]: invalid signature: missing signature")
+
+	received.Signatures = list.Signatures
+	received.Data = received.Data[:2]
+	assert.EqualError(t, keys.VerifyList(received), "data[1]: invalid signature")
+
+	received.Data = received.Data[:1]
+	assert.EqualError(t, keys.VerifyList(received), "signatures: invalid signature: 2 signatures for 1 footprints")
+
+}
+
+// opaqueSigner hides the type of its key, like a key held by an HSM
+type opaqueSigner struct {
+	crypto.Signer
+}
+
+func TestSignOpaqueKey(t *testing.T) {
+
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	ecPrivate, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	keys := KeySet{}
+	assert.Nil(t, keys.Add("ed", edPublic))
+	assert.Nil(t, keys.Add("ec", &ecPrivate.PublicKey))
+
+	footprint := testFootprint()
+	for keyID, key := range map[string]crypto.Signer{"ed": edPrivate, "ec": ecPrivate} {
+		signer, err := NewSigner(keyID, opaqueSigner{key})
+		assert.Nil(t, err)
+		jws, err := signer.Sign(footprint)
+		assert.Nil(t, err, keyID)
+		_, err = keys.Verify(footprint, jws)
+		assert.Nil(t, err, keyID)
+	}
+
+	for _, key := range []crypto.Signer{nil, edPrivate[:10], (*ecdsa.PrivateKey)(nil)} {
+		_, err = NewSigner("invalid", key)
+		assert.ErrorIs(t, err, ErrUnsupportedKey)
+	}
+
+}
+
+func TestKeySetInvalidKeys(t *testing.T) {
+
+	keys := KeySet{}
+	assert.ErrorIs(t, keys.Add("short", ed25519.PublicKey{1, 2, 3}), ErrUnsupportedKey)
+	assert.ErrorIs(t, keys.Add("nil", (*ecdsa.PublicKey)(nil)), ErrUnsupportedKey)
+	assert.Empty(t, keys)
+
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	signer, err := NewSigner("short", private)
+	assert.Nil(t, err)
+	footprint := testFootprint()
+	jws, err := signer.Sign(footprint)
+	assert.Nil(t, err)
+
+	// keys set without Add are checked before verifying
+	keys["short"] = ed25519.PublicKey{1, 2, 3}
+	_, err = keys.Verify(footprint, jws)
+	assert.ErrorIs(t, err, ErrUnsupportedKey)
+
+	keys["short"] = (*ecdsa.PublicKey)(nil)
+	_, err = keys.Verify(footprint, jws)
+	assert.ErrorIs(t, err, ErrUnsupportedKey)
+
+}
@@ -0,0 +1,473 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Error when a field of a logistics extension is missing or invalid
+var ErrLogisticsField = errors.New("invalid logistics extension field")
+
+// The dataSchema of the logistics extensions
+const (
+	ShipmentDataSchema          = "https://catalog.carbon-transparency.com/shipment/1.0.0/data-model.json"
+	ShipmentFootprintDataSchema = "https://api.ileap.sine.dev/shipment-footprint.json"
+	TOCDataSchema               = "https://api.ileap.sine.dev/toc.json"
+	HOCDataSchema               = "https://api.ileap.sine.dev/hoc.json"
+
+	// The other URL the shipment extension schema is published under
+	ShipmentDataSchemaAlias = "https://catalog.carbon-transparency.com/shipment/1.0.0/schema.json"
+)
+
+func init() {
+	if err := RegisterLogisticsExtensions(DefaultExtensions); err != nil {
+		panic(err.Error())
+	}
+}
+
+// RegisterLogisticsExtensions registers the logistics extension types for their dataSchema
+func RegisterLogisticsExtensions(r *ExtensionRegistry) error {
+	r.RegisterAlias(ShipmentDataSchemaAlias, ShipmentDataSchema)
+	return errors.Join(
+		RegisterExtension[Shipment](r, ShipmentDataSchema),
+		RegisterExtension[ShipmentFootprint](r, ShipmentFootprintDataSchema),
+		RegisterExtension[TOC](r, TOCDataSchema),
+		RegisterExtension[HOC](r, HOCDataSchema),
+	)
+}
+
+// Shipment links a ProductFootprint to the shipment of the product
+type Shipment struct {
+
+	// The identifier of the shipment
+	//
+	// Mandatory
+	ShipmentId string `json:"shipmentId"`
+
+	// The identifier of the consignment the shipment is part of
+	//
+	// Optional
+	ConsignmentId string `json:"consignmentId,omitempty"`
+
+	// Whether the shipment is picked up or delivered
+	//
+	// Mandatory
+	ShipmentType ShipmentType `json:"shipmentType"`
+
+	// The weight of the shipment in kilogram, strictly greater than zero
+	//
+	// Mandatory
+	Weight decimal.Decimal `json:"weight"`
+
+	// The identifier of the transport chain element of the shipment
+	//
+	// Mandatory
+	TransportChainElementId string `json:"transportChainElementId"`
+}
+
+// MarshalJSON encodes the weight as a JSON number, as required by the shipment schema
+func (s Shipment) MarshalJSON() ([]byte, error) {
+	type shipment Shipment
+	return json.Marshal(struct {
+		shipment
+		Weight json.Number `json:"weight"`
+	}{shipment(s), json.Number(s.Weight.String())})
+}
+
+// Validate checks the mandatory fields of the Shipment
+func (s Shipment) Validate() error {
+	var errs []error
+	required(&errs, "shipmentId", s.ShipmentId)
+	required(&errs, "shipmentType", string(s.ShipmentType))
+	required(&errs, "transportChainElementId", s.TransportChainElementId)
+	positive(&errs, "weight", s.Weight)
+	return errors.Join(errs...)
+}
+
+// GLECDistance is the distance of a transport chain element,
+// at least one of the distances must be defined
+type GLECDistance struct {
+
+	// The actual distance in km
+	//
+	// Optional
+	Actual *decimal.Decimal `json:"actual,omitempty"`
+
+	// The great circle distance in km
+	//
+	// Optional
+	GCD *decimal.Decimal `json:"gcd,omitempty"`
+
+	// The shortest feasible distance in km
+	//
+	// Optional
+	SFD *decimal.Decimal `json:"sfd,omitempty"`
+}
+
+// Location is the origin or destination of a transport chain element, or the location of a hub
+type Location struct {
+
+	// Optional
+	LocationName string `json:"locationName,omitempty"`
+
+	// Optional
+	LocationStreet string `json:"locationStreet,omitempty"`
+
+	// Optional
+	LocationZip string `json:"locationZip,omitempty"`
+
+	// Optional
+	LocationCity string `json:"locationCity,omitempty"`
+
+	// Optional
+	LocationCountry ISO3166CC `json:"locationCountry,omitempty"`
+
+	// The IATA code of the airport
+	//
+	// Optional
+	Iata string `json:"iata,omitempty"`
+
+	// The UN/LOCODE of the location
+	//
+	// Optional
+	Locode string `json:"locode,omitempty"`
+
+	// Optional
+	Lat *decimal.Decimal `json:"lat,omitempty"`
+
+	// Optional
+	Lng *decimal.Decimal `json:"lng,omitempty"`
+}
+
+// EnergyCarrier is an energy carrier used by a transport or hub operation category
+type EnergyCarrier struct {
+
+	// Mandatory
+	EnergyCarrier EnergyCarrierType `json:"energyCarrier"`
+
+	// The energy consumed per unit of activity
+	//
+	// Optional
+	EnergyConsumption *decimal.Decimal `json:"energyConsumption,omitempty"`
+
+	// The unit of the energy consumption: l, kg, kWh or MJ
+	//
+	// Optional
+	EnergyConsumptionUnit string `json:"energyConsumptionUnit,omitempty"`
+
+	// The well-to-wheel emission factor in kgCO2e per unit of energy consumption
+	//
+	// Mandatory
+	EmissionFactorWTW decimal.Decimal `json:"emissionFactorWTW"`
+
+	// The tank-to-wheel emission factor in kgCO2e per unit of energy consumption
+	//
+	// Mandatory
+	EmissionFactorTTW decimal.Decimal `json:"emissionFactorTTW"`
+
+	// The share of the energy carrier in the energy consumed, between 0 and 1
+	//
+	// Mandatory
+	RelativeShare decimal.Decimal `json:"relativeShare"`
+}
+
+// TCE is a transport chain element: a transport or hub operation of a shipment
+type TCE struct {
+
+	// Mandatory
+	TceId string `json:"tceId"`
+
+	// The transport chain elements preceding this one
+	//
+	// Optional
+	PrevTceIds []string `json:"prevTceIds,omitempty"`
+
+	// The transport operation category of the element, exclusive with hocId
+	//
+	// Optional
+	TocId string `json:"tocId,omitempty"`
+
+	// The hub operation category of the element, exclusive with tocId
+	//
+	// Optional
+	HocId string `json:"hocId,omitempty"`
+
+	// Mandatory
+	ShipmentId string `json:"shipmentId"`
+
+	// The mass of the shipment in kilogram
+	//
+	// Mandatory
+	Mass decimal.Decimal `json:"mass"`
+
+	// The volume of the shipment in cubic meter
+	//
+	// Optional
+	Volume *decimal.Decimal `json:"volume,omitempty"`
+
+	// Mandatory
+	Distance GLECDistance `json:"distance"`
+
+	// Optional
+	Origin *Location `json:"origin,omitempty"`
+
+	// Optional
+	Destination *Location `json:"destination,omitempty"`
+
+	// The transport activity in ton kilometer
+	//
+	// Mandatory
+	TransportActivity decimal.Decimal `json:"transportActivity"`
+
+	// Optional
+	DepartureAt time.Time `json:"departureAt,omitempty"`
+
+	// Optional
+	ArrivalAt time.Time `json:"arrivalAt,omitempty"`
+
+	// The well-to-wheel emissions in kgCO2e
+	//
+	// Mandatory
+	Co2eWTW decimal.Decimal `json:"co2eWTW"`
+
+	// The tank-to-wheel emissions in kgCO2e
+	//
+	// Mandatory
+	Co2eTTW decimal.Decimal `json:"co2eTTW"`
+}
+
+// ShipmentFootprint is the footprint of a shipment, made of its transport chain elements
+type ShipmentFootprint struct {
+
+	// The mass of the shipment in kilogram
+	//
+	// Mandatory
+	Mass decimal.Decimal `json:"mass"`
+
+	// The volume of the shipment in cubic meter
+	//
+	// Optional
+	Volume *decimal.Decimal `json:"volume,omitempty"`
+
+	// Mandatory
+	ShipmentId string `json:"shipmentId"`
+
+	// The non-empty list of transport chain elements of the shipment
+	//
+	// Mandatory
+	Tces []TCE `json:"tces"`
+}
+
+// TOC is a transport operation category
+type TOC struct {
+
+	// Mandatory
+	TocId string `json:"tocId"`
+
+	// Whether the TOC is verified
+	//
+	// Mandatory
+	IsVerified bool `json:"isVerified"`
+
+	// Whether the TOC is accredited
+	//
+	// Mandatory
+	IsAccredited bool `json:"isAccredited"`
+
+	// Optional
+	Description string `json:"description,omitempty"`
+
+	// Mandatory
+	Mode TransportMode `json:"mode"`
+
+	// Optional
+	LoadFactor *decimal.Decimal `json:"loadFactor,omitempty"`
+
+	// Optional
+	EmptyDistanceFactor *decimal.Decimal `json:"emptyDistanceFactor,omitempty"`
+
+	// Optional
+	TemperatureControl TemperatureControl `json:"temperatureControl,omitempty"`
+
+	// The non-empty list of energy carriers, their relative shares adding up to 1
+	//
+	// Mandatory
+	EnergyCarriers []EnergyCarrier `json:"energyCarriers"`
+
+	// The well-to-wheel emission intensity in kgCO2e per unit of throughput
+	//
+	// Mandatory
+	Co2eIntensityWTW decimal.Decimal `json:"co2eIntensityWTW"`
+
+	// The tank-to-wheel emission intensity in kgCO2e per unit of throughput
+	//
+	// Mandatory
+	Co2eIntensityTTW decimal.Decimal `json:"co2eIntensityTTW"`
+
+	// The unit of throughput of the intensities, e.g. tkm
+	//
+	// Mandatory
+	Co2eIntensityThroughput string `json:"co2eIntensityThroughput"`
+}
+
+// HOC is a hub operation category
+type HOC struct {
+
+	// Mandatory
+	HocId string `json:"hocId"`
+
+	// Whether the HOC is verified
+	//
+	// Mandatory
+	IsVerified bool `json:"isVerified"`
+
+	// Whether the HOC is accredited
+	//
+	// Mandatory
+	IsAccredited bool `json:"isAccredited"`
+
+	// Optional
+	Description string `json:"description,omitempty"`
+
+	// Mandatory
+	HubType HubType `json:"hubType"`
+
+	// Optional
+	TemperatureControl TemperatureControl `json:"temperatureControl,omitempty"`
+
+	// Optional
+	HubLocation *Location `json:"hubLocation,omitempty"`
+
+	// The non-empty list of energy carriers, their relative shares adding up to 1
+	//
+	// Mandatory
+	EnergyCarriers []EnergyCarrier `json:"energyCarriers"`
+
+	// The well-to-wheel emission intensity in kgCO2e per unit of throughput
+	//
+	// Mandatory
+	Co2eIntensityWTW decimal.Decimal `json:"co2eIntensityWTW"`
+
+	// The tank-to-wheel emission intensity in kgCO2e per unit of throughput
+	//
+	// Mandatory
+	Co2eIntensityTTW decimal.Decimal `json:"co2eIntensityTTW"`
+
+	// The unit of throughput of the intensities, tonnes or TEU
+	//
+	// Mandatory
+	Co2eIntensityThroughput string `json:"co2eIntensityThroughput"`
+}
+
+// Validate checks the mandatory fields and the transport chain elements of the ShipmentFootprint
+func (s ShipmentFootprint) Validate() error {
+	var errs []error
+	required(&errs, "shipmentId", s.ShipmentId)
+	positive(&errs, "mass", s.Mass)
+	if len(s.Tces) == 0 {
+		errs = append(errs, fmt.Errorf("%w: tces: at least one transport chain element is required", ErrLogisticsField))
+	}
+
+	ids := map[string]bool{}
+	for i, tce := range s.Tces {
+		path := fmt.Sprintf("tces[%d].", i)
+		required(&errs, path+"tceId", tce.TceId)
+		required(&errs, path+"shipmentId", tce.ShipmentId)
+		positive(&errs, path+"mass", tce.Mass)
+		nonNegative(&errs, path+"transportActivity", tce.TransportActivity)
+		nonNegative(&errs, path+"co2eWTW", tce.Co2eWTW)
+		nonNegative(&errs, path+"co2eTTW", tce.Co2eTTW)
+
+		if tce.ShipmentId != "" && tce.ShipmentId != s.ShipmentId {
+			errs = append(errs, fmt.Errorf("%w: %sshipmentId: %s differs from %s", ErrLogisticsField, path, tce.ShipmentId, s.ShipmentId))
+		}
+		if tce.TocId != "" && tce.HocId != "" {
+			errs = append(errs, fmt.Errorf("%w: %stocId, %shocId: at most one can be defined", ErrLogisticsField, path, path))
+		}
+		if tce.Distance.Actual == nil && tce.Distance.GCD == nil && tce.Distance.SFD == nil {
+			errs = append(errs, fmt.Errorf("%w: %sdistance: one of actual, gcd and sfd is required", ErrLogisticsField, path))
+		}
+		if tce.Co2eTTW.GreaterThan(tce.Co2eWTW) {
+			errs = append(errs, fmt.Errorf("%w: %sco2eTTW: greater than co2eWTW", ErrLogisticsField, path))
+		}
+		for _, previous := range tce.PrevTceIds {
+			if !ids[previous] {
+				errs = append(errs, fmt.Errorf("%w: %sprevTceIds: %s is not a preceding transport chain element", ErrLogisticsField, path, previous))
+			}
+		}
+		ids[tce.TceId] = true
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate checks the mandatory fields and the energy carriers of the TOC
+func (t TOC) Validate() error {
+	var errs []error
+	required(&errs, "tocId", t.TocId)
+	required(&errs, "mode", string(t.Mode))
+	required(&errs, "co2eIntensityThroughput", t.Co2eIntensityThroughput)
+	validateIntensities(&errs, t.EnergyCarriers, t.Co2eIntensityWTW, t.Co2eIntensityTTW)
+	return errors.Join(errs...)
+}
+
+// Validate checks the mandatory fields and the energy carriers of the HOC
+func (h HOC) Validate() error {
+	var errs []error
+	required(&errs, "hocId", h.HocId)
+	required(&errs, "hubType", string(h.HubType))
+	required(&errs, "co2eIntensityThroughput", h.Co2eIntensityThroughput)
+	validateIntensities(&errs, h.EnergyCarriers, h.Co2eIntensityWTW, h.Co2eIntensityTTW)
+	return errors.Join(errs...)
+}
+
+// validateIntensities checks the energy carriers and emission intensities of a TOC or HOC
+func validateIntensities(errs *[]error, carriers []EnergyCarrier, wtw, ttw decimal.Decimal) {
+	nonNegative(errs, "co2eIntensityWTW", wtw)
+	nonNegative(errs, "co2eIntensityTTW", ttw)
+	if ttw.GreaterThan(wtw) {
+		*errs = append(*errs, fmt.Errorf("%w: co2eIntensityTTW: greater than co2eIntensityWTW", ErrLogisticsField))
+	}
+
+	if len(carriers) == 0 {
+		*errs = append(*errs, fmt.Errorf("%w: energyCarriers: at least one energy carrier is required", ErrLogisticsField))
+		return
+	}
+
+	share := decimal.Zero
+	for i, carrier := range carriers {
+		path := fmt.Sprintf("energyCarriers[%d].", i)
+		required(errs, path+"energyCarrier", string(carrier.EnergyCarrier))
+		nonNegative(errs, path+"emissionFactorWTW", carrier.EmissionFactorWTW)
+		nonNegative(errs, path+"emissionFactorTTW", carrier.EmissionFactorTTW)
+		if carrier.RelativeShare.IsNegative() || carrier.RelativeShare.GreaterThan(decimal.NewFromInt(1)) {
+			*errs = append(*errs, fmt.Errorf("%w: %srelativeShare: must be between 0 and 1", ErrLogisticsField, path))
+		}
+		share = share.Add(carrier.RelativeShare)
+	}
+	if !share.Equal(decimal.NewFromInt(1)) {
+		*errs = append(*errs, fmt.Errorf("%w: energyCarriers: relative shares add up to %s instead of 1", ErrLogisticsField, share))
+	}
+}
+
+func required(errs *[]error, path, value string) {
+	if value == "" {
+		*errs = append(*errs, fmt.Errorf("%w: %s: required", ErrLogisticsField, path))
+	}
+}
+
+func positive(errs *[]error, path string, value decimal.Decimal) {
+	if !value.IsPositive() {
+		*errs = append(*errs, fmt.Errorf("%w: %s: must be greater than zero", ErrLogisticsField, path))
+	}
+}
+
+func nonNegative(errs *[]error, path string, value decimal.Decimal) {
+	if value.IsNegative() {
+		*errs = append(*errs, fmt.Errorf("%w: %s: must be equal to or greater than zero", ErrLogisticsField, path))
+	}
+}
@@ -0,0 +1,130 @@
+package schema
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShipmentExtension(t *testing.T) {
+
+	data, err := os.ReadFile("../../test_data/extension.json")
+	assert.Nil(t, err)
+
+	var extension DataModelExtension
+	assert.Nil(t, json.Unmarshal(data, &extension))
+
+	product := ProductFootprint{Extensions: []DataModelExtension{extension}}
+	shipment, ok := GetExtension[Shipment](product)
+	assert.True(t, ok)
+	assert.Equal(t, "S1234567890", shipment.ShipmentId)
+	assert.Equal(t, "Cabc.def-ghi", shipment.ConsignmentId)
+	assert.Equal(t, PickupShipment, shipment.ShipmentType)
+	assert.True(t, decimal.NewFromInt(10).Equal(shipment.Weight))
+	assert.Equal(t, "ABCDEFGHI", shipment.TransportChainElementId)
+	assert.Nil(t, product.ValidateExtensions())
+
+	shipment.Weight = decimal.Zero
+	shipment.TransportChainElementId = ""
+	product.Extensions[0].Value = shipment
+	err = product.ValidateExtensions()
+	assert.ErrorIs(t, err, ErrLogisticsField)
+	assert.EqualError(t, err, "extensions[0]: invalid logistics extension field: transportChainElementId: required\n"+
+		"invalid logistics extension field: weight: must be greater than zero")
+
+	err = json.Unmarshal([]byte(`{"specVersion":"2.0.0","dataSchema":"`+ShipmentDataSchema+`","data":{"shipmentType":"pickup"}}`), &extension)
+	assert.ErrorIs(t, err, ErrShipmentTypeParse)
+
+}
+
+func TestShipmentFootprintValidate(t *testing.T) {
+
+	var footprint ShipmentFootprint
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"mass": "87180",
+		"shipmentId": "1237890",
+		"tces": [
+			{
+				"tceId": "abcdef",
+				"tocId": "truck-40t-euro5-de",
+				"shipmentId": "1237890",
+				"mass": "87180",
+				"distance": {"actual": "423"},
+				"transportActivity": "36877.14",
+				"co2eWTW": "3503.33",
+				"co2eTTW": "2902.23"
+			},
+			{
+				"tceId": "ghijkl",
+				"prevTceIds": ["abcdef"],
+				"hocId": "warehouse-de",
+				"shipmentId": "1237890",
+				"mass": "87180",
+				"distance": {"gcd": "0"},
+				"transportActivity": "0",
+				"co2eWTW": "12.5",
+				"co2eTTW": "0"
+			}
+		]
+	}`), &footprint))
+	assert.Nil(t, footprint.Validate())
+
+	footprint.Tces[1].PrevTceIds = []string{"unknown"}
+	footprint.Tces[1].TocId = "truck"
+	footprint.Tces[1].Distance.GCD = nil
+	assert.EqualError(t, footprint.Validate(),
+		"invalid logistics extension field: tces[1].tocId, tces[1].hocId: at most one can be defined\n"+
+			"invalid logistics extension field: tces[1].distance: one of actual, gcd and sfd is required\n"+
+			"invalid logistics extension field: tces[1].prevTceIds: unknown is not a preceding transport chain element")
+
+}
+
+func TestTOCValidate(t *testing.T) {
+
+	toc := TOC{
+		TocId: "truck-40t-euro5-de",
+		Mode:  RoadTransport,
+		EnergyCarriers: []EnergyCarrier{
+			{EnergyCarrier: DieselEnergy, EmissionFactorWTW: decimal.RequireFromString("3.2"), EmissionFactorTTW: decimal.RequireFromString("2.6"), RelativeShare: decimal.RequireFromString("0.8")},
+			{EnergyCarrier: HVOEnergy, EmissionFactorWTW: decimal.RequireFromString("0.5"), EmissionFactorTTW: decimal.Zero, RelativeShare: decimal.RequireFromString("0.2")},
+		},
+		Co2eIntensityWTW:        decimal.RequireFromString("0.095"),
+		Co2eIntensityTTW:        decimal.RequireFromString("0.079"),
+		Co2eIntensityThroughput: "tkm",
+	}
+	assert.Nil(t, toc.Validate())
+
+	toc.EnergyCarriers[1].RelativeShare = decimal.RequireFromString("0.3")
+	assert.EqualError(t, toc.Validate(), "invalid logistics extension field: energyCarriers: relative shares add up to 1.1 instead of 1")
+
+	hoc := HOC{HocId: "warehouse-de", Co2eIntensityThroughput: "tonnes"}
+	err := hoc.Validate()
+	assert.ErrorIs(t, err, ErrLogisticsField)
+	assert.Contains(t, err.Error(), "hubType: required")
+	assert.Contains(t, err.Error(), "energyCarriers: at least one energy carrier is required")
+
+}
+
+func TestShipmentExtensionAlias(t *testing.T) {
+
+	var extension DataModelExtension
+	err := json.Unmarshal([]byte(`{
+		"specVersion": "2.0.0",
+		"dataSchema": "`+ShipmentDataSchemaAlias+`",
+		"data": {"shipmentId": "1234567890", "shipmentType": "PICKUP", "weight": 10, "transportChainElementId": "ABCDEFGHI"}
+	}`), &extension)
+	assert.Nil(t, err)
+
+	shipment, ok := extension.Value.(Shipment)
+	assert.True(t, ok)
+	assert.Equal(t, "1234567890", shipment.ShipmentId)
+
+	// the extension keeps the dataSchema it was received with
+	data, err := json.Marshal(extension)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), ShipmentDataSchemaAlias)
+
+}
@@ -0,0 +1,220 @@
+package schema
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	urn "github.com/leodido/go-urn"
+)
+
+// MatchKind is how a requested identifier matched an identifier of a ProductFootprint
+type MatchKind string
+
+const (
+	// the URNs are equal
+	ExactMatch MatchKind = "exact"
+
+	// the URNs are equivalent once normalized, e.g. a GTIN-13 and its GTIN-14,
+	// or two URNs differing only by the case of their NSS
+	NormalizedMatch MatchKind = "normalized"
+
+	// the URNs are declared equivalent in the alias table of the Matcher
+	AliasMatch MatchKind = "alias"
+)
+
+// The weight of every kind of match in the score
+var matchWeights = map[MatchKind]float64{
+	ExactMatch:      1,
+	NormalizedMatch: 0.9,
+	AliasMatch:      0.75,
+}
+
+// The share of the product identifiers in the score when company identifiers are requested
+const productMatchWeight = 0.8
+
+// IdentityQuery is the set of identifiers of a requested product
+type IdentityQuery struct {
+
+	// The identifiers of the product, at least one must match
+	//
+	// Mandatory
+	ProductIds []urn.URN
+
+	// The identifiers of the supplier
+	//
+	// Optional
+	CompanyIds []urn.URN
+}
+
+// IdentityMatch is a requested identifier matched with an identifier of a ProductFootprint
+type IdentityMatch struct {
+
+	// productIds or companyIds
+	Field string
+
+	// The requested identifier
+	Requested urn.URN
+
+	// The identifier of the ProductFootprint
+	Matched urn.URN
+
+	Kind MatchKind
+}
+
+// FootprintMatch is a candidate ProductFootprint for an IdentityQuery
+type FootprintMatch struct {
+	Footprint ProductFootprint
+
+	// Between 0 and 1, 1 when every requested identifier matched exactly
+	Score float64
+
+	// The best match of every requested identifier which matched
+	Matches []IdentityMatch
+}
+
+// Matcher finds the ProductFootprints identified by the identifiers of a partner,
+// which may use other identifier schemes than the data owner.
+// A Matcher is safe for concurrent use.
+// The zero value is not usable, use NewMatcher.
+type Matcher struct {
+	mu sync.RWMutex
+
+	// the identity keys of the alias table, keyed by identity key
+	aliases map[string]string
+}
+
+// NewMatcher returns a Matcher with an empty alias table
+func NewMatcher() *Matcher {
+	return &Matcher{aliases: map[string]string{}}
+}
+
+// AddAlias declares two identifiers as equivalent, e.g. a buyer-assigned
+// custom code and the GTIN of the product. Aliases are transitive.
+func (m *Matcher) AddAlias(a, b urn.URN) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rootA, rootB := m.compress(identityKey(a)), m.compress(identityKey(b))
+	if rootA != rootB {
+		m.aliases[rootA] = rootB
+	}
+}
+
+// compress points the aliases of the path from the key to its root at the root, and returns the root
+func (m *Matcher) compress(key string) string {
+	root := m.root(key)
+	for key != root {
+		parent := m.aliases[key]
+		m.aliases[key] = root
+		key = parent
+	}
+	return root
+}
+
+// root returns the representative identity key of the aliases of the key
+func (m *Matcher) root(key string) string {
+	for {
+		parent, ok := m.aliases[key]
+		if !ok {
+			return key
+		}
+		key = parent
+	}
+}
+
+// identityKey returns the normalized form of an identifier: GTINs and the GTIN of SGTINs
+// as GTIN-14, and other URNs with their NID and NSS in lower case
+func identityKey(u urn.URN) string {
+	if identifier, err := ParseIdentifier(u); err == nil {
+		switch identifier.Scheme {
+		case GTINScheme:
+			return "gtin:" + strings.Repeat("0", 14-len(identifier.Value)) + identifier.Value
+		case SGTINScheme:
+			return "gtin:" + sgtinGTIN(identifier.Value)
+		}
+	}
+	return strings.ToLower(u.ID) + ":" + strings.ToLower(u.SS)
+}
+
+// sgtinGTIN returns the GTIN-14 of an SGTIN pure identity: the indicator digit,
+// the company prefix, the item reference and the check digit
+func sgtinGTIN(value string) string {
+	parts := strings.SplitN(value, ".", 3)
+	digits := parts[1][:1] + parts[0] + parts[1][1:]
+	return digits + string(gs1CheckDigit(digits))
+}
+
+// match returns the best match of the requested identifier among the identifiers
+func (m *Matcher) match(field string, requested urn.URN, identifiers []urn.URN) (IdentityMatch, bool) {
+	best, found := IdentityMatch{Field: field, Requested: requested}, false
+	requestedKey := identityKey(requested)
+
+	for _, identifier := range identifiers {
+		var kind MatchKind
+		switch key := identityKey(identifier); {
+		case requested.Equal(&identifier):
+			kind = ExactMatch
+		case key == requestedKey:
+			kind = NormalizedMatch
+		case m.root(key) == m.root(requestedKey):
+			kind = AliasMatch
+		default:
+			continue
+		}
+
+		if !found || matchWeights[kind] > matchWeights[best.Kind] {
+			best.Matched, best.Kind, found = identifier, kind, true
+		}
+	}
+
+	return best, found
+}
+
+// score returns the mean weight of the best matches of the requested identifiers
+func (m *Matcher) score(field string, requested, identifiers []urn.URN, matches *[]IdentityMatch) float64 {
+	if len(requested) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, identifier := range requested {
+		if match, ok := m.match(field, identifier, identifiers); ok {
+			*matches = append(*matches, match)
+			total += matchWeights[match.Kind]
+		}
+	}
+	return total / float64(len(requested))
+}
+
+// Match returns the footprints of the pool matching at least one of the requested
+// product identifiers, the best match first.
+// The score is the share of the requested identifiers matched, weighted by the kind of match.
+// When company identifiers are requested, they account for 20% of the score.
+func (m *Matcher) Match(query IdentityQuery, pool []ProductFootprint) []FootprintMatch {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []FootprintMatch
+	for _, footprint := range pool {
+		candidate := FootprintMatch{Footprint: footprint}
+
+		score := m.score("productIds", query.ProductIds, footprint.ProductIds, &candidate.Matches)
+		if score == 0 {
+			continue
+		}
+		if len(query.CompanyIds) > 0 {
+			company := m.score("companyIds", query.CompanyIds, footprint.CompanyIds, &candidate.Matches)
+			score = productMatchWeight*score + (1-productMatchWeight)*company
+		}
+
+		candidate.Score = score
+		result = append(result, candidate)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Score > result[j].Score
+	})
+
+	return result
+}
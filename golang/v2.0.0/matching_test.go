@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"sync"
+	"testing"
+
+	urn "github.com/leodido/go-urn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchIdentity(t *testing.T) {
+
+	gtin13 := ProductFootprint{
+		Version:    1,
+		CompanyIds: []urn.URN{mustURN(t, "urn:lei:5493001KJTIIGC8Y1R12")},
+		ProductIds: []urn.URN{mustURN(t, "urn:gtin:4712345060507")},
+	}
+	gtin14 := ProductFootprint{
+		Version:    2,
+		ProductIds: []urn.URN{mustURN(t, "urn:gtin:04712345060507")},
+	}
+	sgtin := ProductFootprint{
+		Version:    3,
+		ProductIds: []urn.URN{mustURN(t, "urn:epc:id:sgtin:0614141.112345.400")},
+	}
+	vendor := ProductFootprint{
+		Version:    4,
+		ProductIds: []urn.URN{mustURN(t, "urn:pathfinder:product:customcode:vendor-assigned:ABC-1")},
+	}
+	unrelated := ProductFootprint{
+		Version:    5,
+		ProductIds: []urn.URN{mustURN(t, "urn:gtin:96385074")},
+	}
+	pool := []ProductFootprint{unrelated, vendor, sgtin, gtin14, gtin13}
+
+	matcher := NewMatcher()
+	matches := matcher.Match(IdentityQuery{
+		ProductIds: []urn.URN{mustURN(t, "urn:gtin:4712345060507")},
+		CompanyIds: []urn.URN{mustURN(t, "urn:LEI:5493001KJTIIGC8Y1R12")},
+	}, pool)
+
+	assert.Len(t, matches, 2)
+	assert.Equal(t, int32(1), matches[0].Footprint.Version)
+	assert.Equal(t, 1.0, matches[0].Score)
+	assert.Equal(t, int32(2), matches[1].Footprint.Version)
+	assert.Equal(t, NormalizedMatch, matches[1].Matches[0].Kind)
+	assert.InDelta(t, 0.72, matches[1].Score, 1e-9)
+
+	matches = matcher.Match(IdentityQuery{
+		ProductIds: []urn.URN{mustURN(t, "urn:gtin:10614141123459")},
+	}, pool)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, int32(3), matches[0].Footprint.Version)
+
+	buyer := mustURN(t, "urn:pathfinder:product:customcode:buyer-assigned:xyz")
+	assert.Empty(t, matcher.Match(IdentityQuery{ProductIds: []urn.URN{buyer}}, pool))
+
+	matcher.AddAlias(buyer, mustURN(t, "urn:gtin:4712345060507"))
+	matcher.AddAlias(mustURN(t, "urn:pathfinder:product:customcode:vendor-assigned:abc-1"), buyer)
+	matches = matcher.Match(IdentityQuery{ProductIds: []urn.URN{buyer}}, pool)
+	assert.Len(t, matches, 3)
+	for _, match := range matches {
+		assert.Equal(t, AliasMatch, match.Matches[0].Kind)
+		assert.Equal(t, 0.75, match.Score)
+	}
+
+}
+
+func TestMatcherConcurrency(t *testing.T) {
+
+	matcher := NewMatcher()
+	pool := []ProductFootprint{{ProductIds: []urn.URN{mustURN(t, "urn:gtin:4712345060507")}}}
+	codes := []urn.URN{
+		mustURN(t, "urn:pathfinder:product:customcode:buyer-assigned:a"),
+		mustURN(t, "urn:pathfinder:product:customcode:buyer-assigned:b"),
+		mustURN(t, "urn:pathfinder:product:customcode:buyer-assigned:c"),
+		mustURN(t, "urn:pathfinder:product:customcode:buyer-assigned:d"),
+	}
+	for i := 1; i < len(codes); i++ {
+		matcher.AddAlias(codes[i-1], codes[i])
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			matcher.AddAlias(codes[len(codes)-1], mustURN(t, "urn:gtin:4712345060507"))
+		}()
+		go func() {
+			defer wg.Done()
+			matcher.Match(IdentityQuery{ProductIds: codes[:1]}, pool)
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, matcher.Match(IdentityQuery{ProductIds: codes[:1]}, pool), 1)
+
+}
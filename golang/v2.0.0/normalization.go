@@ -0,0 +1,271 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Error when an alias refers to an invalid enum value
+var ErrAliasCanonical = errors.New("alias of an unsupported value")
+
+// Normalization records a variant of an enum value replaced by its canonical value
+type Normalization struct {
+
+	// The JSON path of the value, e.g. pcf.declaredUnit
+	Path string `json:"path"`
+
+	// The name of the enum type, e.g. DeclaredUnit
+	Type string `json:"type"`
+
+	// The value as received
+	Original string `json:"original"`
+
+	// The canonical value it was replaced with
+	Canonical string `json:"canonical"`
+}
+
+// enum is implemented by the generated enum types
+type enum[T any] interface {
+	~string
+	IsValid() bool
+	Values() []T
+}
+
+// aliasTable is the normalization table of an enum type
+type aliasTable struct {
+
+	// the canonical values keyed by their folded value
+	canonical map[string]string
+
+	// the canonical values keyed by their folded alias
+	aliases map[string]string
+}
+
+// Normalizer maps the variants of enum values found in supplier data,
+// e.g. "Kilogram", "kg" or "ISO 14067", to their canonical values.
+//
+// Values are matched regardless of case, spacing and punctuation, and
+// through configurable alias tables. The zero value is not usable,
+// use NewNormalizer.
+type Normalizer struct {
+	tables map[reflect.Type]*aliasTable
+}
+
+// registerEnum adds the table of the enum type T to the normalizer
+func registerEnum[T enum[T]](n *Normalizer) {
+	var zero T
+	table := &aliasTable{
+		canonical: map[string]string{},
+		aliases:   map[string]string{},
+	}
+	for _, value := range zero.Values() {
+		table.canonical[foldEnum(string(value))] = string(value)
+	}
+	n.tables[reflect.TypeOf(zero)] = table
+}
+
+// AddAlias adds an alias of a canonical value of the enum type T to the normalizer.
+// The alias is matched regardless of case, spacing and punctuation.
+func AddAlias[T enum[T]](n *Normalizer, alias string, canonical T) error {
+	if !canonical.IsValid() {
+		return fmt.Errorf("%w: %s %q", ErrAliasCanonical, reflect.TypeOf(canonical).Name(), canonical)
+	}
+
+	table, ok := n.tables[reflect.TypeOf(canonical)]
+	if !ok {
+		registerEnum[T](n)
+		table = n.tables[reflect.TypeOf(canonical)]
+	}
+
+	table.aliases[foldEnum(alias)] = string(canonical)
+	return nil
+}
+
+// NewNormalizer returns a Normalizer with the default alias tables
+// of all the enums of the schema
+func NewNormalizer() *Normalizer {
+	n := &Normalizer{tables: map[reflect.Type]*aliasTable{}}
+
+	registerEnum[Status](n)
+	registerEnum[DeclaredUnit](n)
+	registerEnum[CharacterizationFactor](n)
+	registerEnum[Standard](n)
+	registerEnum[PCROperator](n)
+	registerEnum[AccountingMethodology](n)
+	registerEnum[Coverage](n)
+	registerEnum[AssuranceLevel](n)
+	registerEnum[AssuranceBoundary](n)
+	registerEnum[RegionOrSubregion](n)
+	registerEnum[GreenhouseGas](n)
+	registerEnum[ShipmentType](n)
+	registerEnum[TransportMode](n)
+	registerEnum[HubType](n)
+	registerEnum[TemperatureControl](n)
+	registerEnum[EnergyCarrierType](n)
+	registerEnum[AttachmentType](n)
+
+	for alias, canonical := range declaredUnitAliases {
+		_ = AddAlias(n, alias, canonical)
+	}
+	for alias, canonical := range standardAliases {
+		_ = AddAlias(n, alias, canonical)
+	}
+	for alias, canonical := range characterizationFactorAliases {
+		_ = AddAlias(n, alias, canonical)
+	}
+	for alias, canonical := range pcrOperatorAliases {
+		_ = AddAlias(n, alias, canonical)
+	}
+	for alias, canonical := range coverageAliases {
+		_ = AddAlias(n, alias, canonical)
+	}
+	for alias, canonical := range regionOrSubregionAliases {
+		_ = AddAlias(n, alias, canonical)
+	}
+
+	return n
+}
+
+var declaredUnitAliases = map[string]DeclaredUnit{
+	"l":               Liter,
+	"litre":           Liter,
+	"liters":          Liter,
+	"litres":          Liter,
+	"kg":              KiloGram,
+	"kgs":             KiloGram,
+	"kilograms":       KiloGram,
+	"kilogramme":      KiloGram,
+	"m3":              CubicMeter,
+	"m^3":             CubicMeter,
+	"m³":              CubicMeter,
+	"cbm":             CubicMeter,
+	"cubic metre":     CubicMeter,
+	"kWh":             KiloWattHour,
+	"kilowatt-hour":   KiloWattHour,
+	"kilowatt hours":  KiloWattHour,
+	"MJ":              MegaJoule,
+	"megajoules":      MegaJoule,
+	"tkm":             TonKilometer,
+	"tonne kilometer": TonKilometer,
+	"tonne kilometre": TonKilometer,
+	"ton km":          TonKilometer,
+	"m2":              SquareMeter,
+	"m^2":             SquareMeter,
+	"m²":              SquareMeter,
+	"sqm":             SquareMeter,
+	"sq m":            SquareMeter,
+	"square metre":    SquareMeter,
+}
+
+var standardAliases = map[string]Standard{
+	"GHG Protocol":                  GHGProtocol,
+	"GHGP":                          GHGProtocol,
+	"GHGP Product":                  GHGProtocol,
+	"GHG Protocol Product":          GHGProtocol,
+	"GHG Protocol Product Standard": GHGProtocol,
+	"ISO 14067":                     ISO14067,
+	"ISO 14067:2018":                ISO14067,
+	"ISO 14044":                     ISO14044,
+	"ISO 14044:2006":                ISO14044,
+}
+
+var characterizationFactorAliases = map[string]CharacterizationFactor{
+	"IPCC AR6": AR6,
+	"IPCC AR5": AR5,
+}
+
+var pcrOperatorAliases = map[string]PCROperator{
+	"EPD":                             EPD,
+	"EPD International AB":            EPD,
+	"Environdec":                      EPD,
+	"Product Environmental Footprint": PEF,
+	"EU PEF":                          PEF,
+}
+
+var coverageAliases = map[string]Coverage{
+	"corporate": Corporate,
+	"product":   Product,
+	"PCF":       PCF,
+}
+
+var regionOrSubregionAliases = map[string]RegionOrSubregion{
+	"ANZ":                           AustraliaAndNewZealand,
+	"Latin America and Caribbean":   LatinAmericaAndCaribbean,
+	"Latin America & the Caribbean": LatinAmericaAndCaribbean,
+	"Latin America & Caribbean":     LatinAmericaAndCaribbean,
+	"LATAM":                         LatinAmericaAndCaribbean,
+	"North America":                 NorthernAmerica,
+	"South East Asia":               SouthEasternAsia,
+	"Southeast Asia":                SouthEasternAsia,
+	"SEA":                           SouthEasternAsia,
+	"Subsahara Africa":              SubSaharanAfrica,
+	"West Europe":                   WesternEurope,
+	"East Europe":                   EasternEurope,
+}
+
+// normalize returns the canonical value of a variant of the enum type t
+func (n *Normalizer) normalize(t reflect.Type, value string) (string, bool) {
+	table, ok := n.tables[t]
+	if !ok {
+		return value, false
+	}
+
+	folded := foldEnum(value)
+	if canonical, ok := table.canonical[folded]; ok {
+		return canonical, canonical != value
+	}
+	if canonical, ok := table.aliases[folded]; ok {
+		return canonical, canonical != value
+	}
+
+	return value, false
+}
+
+// Normalize returns the canonical value of a variant of a value of the enum type T,
+// and whether the value was normalized
+func Normalize[T enum[T]](n *Normalizer, value string) (T, bool) {
+	var zero T
+	canonical, normalized := n.normalize(reflect.TypeOf(zero), value)
+	return T(canonical), normalized
+}
+
+// Unmarshal parses the JSON-encoded data into v, replacing the variants of the enum values
+// with their canonical values. It returns every normalization applied.
+// Values which can not be normalized are reported like Unmarshal.
+func (n *Normalizer) Unmarshal(data []byte, v any) ([]Normalization, error) {
+	document, err := decodeOrderedJSON(data)
+	if err != nil {
+		return nil, Unmarshal(data, v)
+	}
+
+	var normalizations []Normalization
+	document, _ = walkJSONStrings(document, reflect.TypeOf(v), "", func(path string, t reflect.Type, value string) (string, bool) {
+		if canonical, ok := n.normalize(t, value); ok {
+			normalizations = append(normalizations, Normalization{
+				Path:      path,
+				Type:      t.Name(),
+				Original:  value,
+				Canonical: canonical,
+			})
+			return canonical, true
+		}
+		return value, true
+	})
+
+	sort.Slice(normalizations, func(i, j int) bool {
+		return normalizations[i].Path < normalizations[j].Path
+	})
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+
+	return normalizations, Unmarshal(buffer.Bytes(), v)
+}
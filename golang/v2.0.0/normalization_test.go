@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+
+	normalizer := NewNormalizer()
+
+	tests := []struct {
+		value      string
+		canonical  DeclaredUnit
+		normalized bool
+	}{
+		{"kilogram", KiloGram, false},
+		{"Kilogram", KiloGram, true},
+		{"kg", KiloGram, true},
+		{"kWh", KiloWattHour, true},
+		{"pound", DeclaredUnit("pound"), false},
+	}
+
+	for _, test := range tests {
+		canonical, normalized := Normalize[DeclaredUnit](normalizer, test.value)
+		assert.Equal(t, test.canonical, canonical)
+		assert.Equal(t, test.normalized, normalized)
+	}
+
+	err := AddAlias(normalizer, "pound", DeclaredUnit("lb"))
+	assert.ErrorIs(t, err, ErrAliasCanonical)
+
+}
+
+func TestNormalizerUnmarshal(t *testing.T) {
+
+	testData := `{
+		"pcf": {
+			"declaredUnit": "kg",
+			"unitaryProductAmount": "12.000",
+			"characterizationFactors": "ar6",
+			"crossSectoralStandardsUsed": ["ISO 14067"],
+			"productOrSectorSpecificRules": [{"operator": "EPD", "ruleNames": ["ABC 2021"]}],
+			"geographyRegionOrSubregion": "WesternEurope",
+			"assurance": {"coverage": "product line", "boundary": "gate to gate"}
+		}
+	}`
+
+	normalizer := NewNormalizer()
+	assert.Nil(t, AddAlias(normalizer, "Western EU", WesternEurope))
+
+	var footprint ProductFootprint
+	normalizations, err := normalizer.Unmarshal([]byte(testData), &footprint)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []Normalization{
+		{Path: "pcf.assurance.boundary", Type: "AssuranceBoundary", Original: "gate to gate", Canonical: "Gate-to-Gate"},
+		{Path: "pcf.characterizationFactors", Type: "CharacterizationFactor", Original: "ar6", Canonical: "AR6"},
+		{Path: "pcf.crossSectoralStandardsUsed[0]", Type: "Standard", Original: "ISO 14067", Canonical: "ISO Standard 14067"},
+		{Path: "pcf.declaredUnit", Type: "DeclaredUnit", Original: "kg", Canonical: "kilogram"},
+		{Path: "pcf.geographyRegionOrSubregion", Type: "RegionOrSubregion", Original: "WesternEurope", Canonical: "Western Europe"},
+		{Path: "pcf.productOrSectorSpecificRules[0].operator", Type: "PCROperator", Original: "EPD", Canonical: "EPD International"},
+	}, normalizations)
+
+	assert.Equal(t, KiloGram, footprint.Pcf.DeclaredUnit)
+	assert.Equal(t, "12", footprint.Pcf.UnitaryProductAmount.String())
+	assert.Equal(t, EPD, footprint.Pcf.ProductOrSectorSpecificRules[0].Operator)
+
+	_, err = normalizer.Unmarshal([]byte(`{"pcf": {"declaredUnit": "pound"}}`), &footprint)
+	assert.ErrorIs(t, err, ErrDeclaredUnitParse)
+
+}
@@ -1,5 +1,30 @@
 package schema
 
+import (
+	"encoding/json"
+	"strconv"
+)
+
 // Defines a percentage type as float64 alias
 // according to the PATHFINDER spec
 type Percentage float64
+
+// UnmarshalJSON accepts a JSON number, or a string holding a number as exchanged by some implementations.
+// Like for a float64, null leaves the percentage unchanged, and the empty string is rejected.
+func (p *Percentage) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return err
+	}
+
+	value, err := strconv.ParseFloat(number.String(), 64)
+	if err != nil {
+		return err
+	}
+	*p = Percentage(value)
+	return nil
+}
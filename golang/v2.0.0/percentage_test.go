@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentageUnmarshalJSON(t *testing.T) {
+
+	for data, expected := range map[string]Percentage{
+		`0`:     0,
+		`2.5`:   2.5,
+		`"0.0"`: 0,
+		`"2.5"`: 2.5,
+	} {
+		var p Percentage
+		assert.Nil(t, json.Unmarshal([]byte(data), &p), data)
+		assert.Equal(t, expected, p, data)
+	}
+
+	for _, data := range []string{`"abc"`, `""`, `true`} {
+		var p Percentage
+		assert.NotNil(t, json.Unmarshal([]byte(data), &p), data)
+	}
+
+	var quality DataQualityIndicators
+	assert.Nil(t, json.Unmarshal([]byte(`{"coveragePercent": null}`), &quality))
+	assert.Equal(t, Percentage(0), quality.CoveragePercent)
+
+	var footprint CarbonFootprint
+	assert.Nil(t, json.Unmarshal([]byte(`{"exemptedEmissionsPercent": null, "primaryDataShare": null}`), &footprint))
+	assert.Equal(t, Percentage(0), footprint.ExemptedEmissionsPercent)
+
+}
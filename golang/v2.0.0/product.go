@@ -117,7 +117,7 @@ type ProductFootprint struct {
 	// UN CPC Code
 	//
 	// Mandatory
-	ProductCategoryCpc string `json:"productCategoryCpc"`
+	ProductCategoryCpc CPCCode `json:"productCategoryCpc"`
 
 	// The non-empty trade name of the product.
 	//
@@ -0,0 +1,183 @@
+package schema
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// Error when no inputs are given to an aggregation
+var ErrNoInputs = errors.New("no inputs to aggregate")
+
+// Error when an input reports negative emissions
+var ErrNegativeInputEmissions = errors.New("input emissions must be equal to or greater than zero")
+
+// Error when the inputs do not contribute any emissions
+var ErrZeroTotalEmissions = errors.New("total emissions of the inputs must be greater than zero")
+
+// Error when an input reports a primary data share outside 0..100
+var ErrInputPrimaryDataShare = errors.New("input primary data share must be between 0 and 100")
+
+// Error when an input reports a data quality rating outside 1..3
+var ErrInputDQR = errors.New("input data quality rating must be between 1 and 3")
+
+// The share of the PCF emissions (in percent) an input must exceed
+// in order to be included in the data quality assessment.
+// See Pathfinder Framework Section 4.2.3.
+const DataQualityThresholdPercent = 5
+
+var (
+	hundred    = decimal.NewFromInt(100)
+	dqrMinimum = decimal.NewFromInt(1)
+	dqrMaximum = decimal.NewFromInt(3)
+)
+
+// PCFInput is a material or energy input, or any other component such as
+// the direct emissions from production, contributing to the PCF of a product.
+type PCFInput struct {
+
+	// The GHG contribution of the input to the studied product's PCF.
+	// It can be expressed either in kgCO2e or as a share of the PCF,
+	// the values are normalised across all inputs.
+	//
+	// Mandatory
+	Emissions decimal.Decimal
+
+	// A boolean flag indicating whether both the activity data and the emission factor
+	// of the input comply with the primary data definitions (Pathfinder Framework Table 5).
+	// It is only used when PrimaryDataShare is undefined.
+	//
+	// Optional
+	Primary bool
+
+	// If present, the primary data share received from the supplier of the input (tier n-1).
+	// It takes precedence over the Primary flag.
+	//
+	// Optional
+	PrimaryDataShare *Percentage
+
+	// If present, the data quality indicators of the input.
+	// Inputs representing >5% of PCF emissions without data quality indicators
+	// are excluded from the data quality assessment, lowering its coverage.
+	//
+	// Optional
+	Dqi *DataQualityIndicators
+}
+
+// primaryDataShare returns the PDS of the input in percent
+func (i PCFInput) primaryDataShare() (decimal.Decimal, error) {
+	if i.PrimaryDataShare != nil {
+		if *i.PrimaryDataShare < 0 || *i.PrimaryDataShare > 100 {
+			return decimal.Zero, ErrInputPrimaryDataShare
+		}
+		return decimal.NewFromFloat(float64(*i.PrimaryDataShare)), nil
+	}
+
+	if i.Primary {
+		return hundred, nil
+	}
+
+	return decimal.Zero, nil
+}
+
+// inputShares returns the relative contribution (0..1) of every input to the PCF
+func inputShares(inputs []PCFInput) ([]decimal.Decimal, error) {
+	if len(inputs) == 0 {
+		return nil, ErrNoInputs
+	}
+
+	total := decimal.Zero
+	for _, input := range inputs {
+		if input.Emissions.IsNegative() {
+			return nil, ErrNegativeInputEmissions
+		}
+		total = total.Add(input.Emissions)
+	}
+
+	if !total.IsPositive() {
+		return nil, ErrZeroTotalEmissions
+	}
+
+	shares := make([]decimal.Decimal, len(inputs))
+	for i, input := range inputs {
+		shares[i] = input.Emissions.Div(total)
+	}
+
+	return shares, nil
+}
+
+// AggregatePrimaryDataShare calculates the PDS of a PCF using the weighted average
+// approach of the Pathfinder Framework (Section 4.2.2): the PDS of every input is
+// multiplied by its relative contribution to the PCF emissions and the weighted
+// values are added up.
+func AggregatePrimaryDataShare(inputs []PCFInput) (Percentage, error) {
+	shares, err := inputShares(inputs)
+	if err != nil {
+		return 0, err
+	}
+
+	pds := decimal.Zero
+	for i, input := range inputs {
+		inputPds, err := input.primaryDataShare()
+		if err != nil {
+			return 0, err
+		}
+		pds = pds.Add(inputPds.Mul(shares[i]))
+	}
+
+	return Percentage(pds.InexactFloat64()), nil
+}
+
+// AggregateDataQualityIndicators calculates the DQRs of a PCF as the weighted average
+// of the DQRs of all the inputs representing >5% of the PCF emissions (Pathfinder Framework Section 4.2.3).
+// The CoveragePercent is the share of the PCF emissions included in the assessment.
+func AggregateDataQualityIndicators(inputs []PCFInput) (DataQualityIndicators, error) {
+	shares, err := inputShares(inputs)
+	if err != nil {
+		return DataQualityIndicators{}, err
+	}
+
+	threshold := decimal.NewFromInt(DataQualityThresholdPercent).Div(hundred)
+
+	var technological, temporal, geographical, completeness, reliability decimal.Decimal
+	coverage := decimal.Zero
+
+	for i, input := range inputs {
+		if input.Dqi == nil || !shares[i].GreaterThan(threshold) {
+			continue
+		}
+
+		dqi := input.Dqi
+		for _, dqr := range []decimal.Decimal{
+			dqi.TechnologicalDQR,
+			dqi.TemporalDQR,
+			dqi.GeographicalDQR,
+			dqi.CompletenessDQR,
+			dqi.ReliabilityDQR,
+		} {
+			if dqr.LessThan(dqrMinimum) || dqr.GreaterThan(dqrMaximum) {
+				return DataQualityIndicators{}, ErrInputDQR
+			}
+		}
+
+		technological = technological.Add(dqi.TechnologicalDQR.Mul(shares[i]))
+		temporal = temporal.Add(dqi.TemporalDQR.Mul(shares[i]))
+		geographical = geographical.Add(dqi.GeographicalDQR.Mul(shares[i]))
+		completeness = completeness.Add(dqi.CompletenessDQR.Mul(shares[i]))
+		reliability = reliability.Add(dqi.ReliabilityDQR.Mul(shares[i]))
+		coverage = coverage.Add(shares[i])
+	}
+
+	if coverage.IsZero() {
+		return DataQualityIndicators{}, nil
+	}
+
+	return DataQualityIndicators{
+		CoveragePercent:  Percentage(coverage.Mul(hundred).InexactFloat64()),
+		TechnologicalDQR: technological.Div(coverage),
+		TemporalDQR:      temporal.Div(coverage),
+		GeographicalDQR:  geographical.Div(coverage),
+		CompletenessDQR:  completeness.Div(coverage),
+		ReliabilityDQR:   reliability.Div(coverage),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func percentage(value float64) *Percentage {
+	p := Percentage(value)
+	return &p
+}
+
+func TestAggregatePrimaryDataShare(t *testing.T) {
+
+	inputs := []PCFInput{
+		{Emissions: decimal.NewFromInt(60), PrimaryDataShare: percentage(50)},
+		{Emissions: decimal.NewFromInt(30), Primary: true},
+		{Emissions: decimal.NewFromInt(10)},
+	}
+
+	pds, err := AggregatePrimaryDataShare(inputs)
+	assert.Nil(t, err)
+
+	assert.InDelta(t, 60.0, float64(pds), 1e-9)
+
+}
+
+func TestAggregatePrimaryDataShareErrors(t *testing.T) {
+
+	_, err := AggregatePrimaryDataShare(nil)
+	assert.ErrorIs(t, err, ErrNoInputs)
+
+	_, err = AggregatePrimaryDataShare([]PCFInput{{Emissions: decimal.NewFromInt(-1)}})
+	assert.ErrorIs(t, err, ErrNegativeInputEmissions)
+
+	_, err = AggregatePrimaryDataShare([]PCFInput{{Emissions: decimal.Zero}})
+	assert.ErrorIs(t, err, ErrZeroTotalEmissions)
+
+	_, err = AggregatePrimaryDataShare([]PCFInput{{Emissions: decimal.NewFromInt(1), PrimaryDataShare: percentage(101)}})
+	assert.ErrorIs(t, err, ErrInputPrimaryDataShare)
+
+}
+
+func TestAggregateDataQualityIndicators(t *testing.T) {
+
+	dqi := func(value int64) *DataQualityIndicators {
+		dqr := decimal.NewFromInt(value)
+		return &DataQualityIndicators{
+			TechnologicalDQR: dqr,
+			TemporalDQR:      dqr,
+			GeographicalDQR:  dqr,
+			CompletenessDQR:  dqr,
+			ReliabilityDQR:   dqr,
+		}
+	}
+
+	inputs := []PCFInput{
+		{Emissions: decimal.NewFromInt(60), Dqi: dqi(1)},
+		{Emissions: decimal.NewFromInt(20), Dqi: dqi(3)},
+		// below the 5% threshold
+		{Emissions: decimal.NewFromInt(4), Dqi: dqi(3)},
+		// no data quality indicators
+		{Emissions: decimal.NewFromInt(16)},
+	}
+
+	result, err := AggregateDataQualityIndicators(inputs)
+	assert.Nil(t, err)
+
+	assert.InDelta(t, 80.0, float64(result.CoveragePercent), 1e-9)
+	assert.True(t, result.TechnologicalDQR.Equal(decimal.RequireFromString("1.5")))
+	assert.True(t, result.ReliabilityDQR.Equal(decimal.RequireFromString("1.5")))
+
+	inputs[0].Dqi = dqi(4)
+	_, err = AggregateDataQualityIndicators(inputs)
+	assert.ErrorIs(t, err, ErrInputDQR)
+
+}
@@ -0,0 +1,143 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Error when a GHG emission property can not be recalculated without its gas breakdown
+var ErrGasBreakdownMissing = errors.New("missing gas breakdown")
+
+// Error when the gas breakdown is not a map of GHG emission properties to gas inventories
+var ErrGasBreakdownFormat = errors.New("invalid gas breakdown")
+
+// Error when the gas breakdown does not add up to the reported GHG emissions
+var ErrGasBreakdownMismatch = errors.New("gas breakdown does not match the reported emissions")
+
+// The relative difference allowed between the reported GHG emissions
+// and the CO2e of their gas breakdown under the reported characterization factors
+var gasBreakdownTolerance = decimal.New(1, -3)
+
+// GasBreakdown is the per gas inventory of the GHG emission properties of a CarbonFootprint,
+// keyed by the property JSON name, e.g.
+//
+//	{
+//	  "pCfExcludingBiogenic": { "CO2": "1.2", "CH4 fossil": "0.01" },
+//	  "fossilGhgEmissions": { "CO2": "1.2", "CH4 fossil": "0.01" }
+//	}
+//
+// The amounts are in kg of gas per declared unit.
+type GasBreakdown map[string]GasInventory
+
+// ParseGasBreakdown decodes the GasBreakdown carried by the data of a DataModelExtension
+func ParseGasBreakdown(extension DataModelExtension) (GasBreakdown, error) {
+	data, err := extension.Encoded()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 || string(data) == "null" {
+		return nil, fmt.Errorf("%w: no data in extension %s", ErrGasBreakdownMissing, extension.DataSchema)
+	}
+
+	var breakdown GasBreakdown
+	if err := json.Unmarshal(data, &breakdown); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGasBreakdownFormat, err)
+	}
+	return breakdown, nil
+}
+
+// Recalculation is the result of recalculating a CarbonFootprint
+// under another CharacterizationFactor
+type Recalculation struct {
+
+	// The recalculated CarbonFootprint
+	Footprint CarbonFootprint
+
+	// The CharacterizationFactor of the original CarbonFootprint
+	From CharacterizationFactor
+
+	// The CharacterizationFactor of the recalculated CarbonFootprint
+	To CharacterizationFactor
+
+	// The change (recalculated - original) of every recalculated property, keyed by JSON name
+	Deltas map[string]decimal.Decimal
+}
+
+// ghgEmissionQuantities returns the properties of the CarbonFootprint expressed in kgCO2e / declaredUnit
+// which depend on the characterization factors.
+// The BiogenicCarbonWithdrawal only accounts for CO2 and is therefore not included.
+func (c *CarbonFootprint) ghgEmissionQuantities() []namedQuantity {
+	return []namedQuantity{
+		{"pCfExcludingBiogenic", &c.PCfExcludingBiogenic},
+		{"pCfIncludingBiogenic", c.includingBiogenic()},
+		{"fossilGhgEmissions", &c.FossilGhgEmissions},
+		{"dLucGhgEmissions", &c.DLucGhgEmissions},
+		{"landManagementGhgEmissions", &c.LandManagementGhgEmissions},
+		{"otherBiogenicGhgEmissions", &c.OtherBiogenicGhgEmissions},
+		{"iLucGhgEmissions", &c.ILucGhgEmissions},
+		{"aircraftGhgEmissions", &c.AircraftGhgEmissions},
+		{"packagingGhgEmissions", &c.PackagingGhgEmissions},
+	}
+}
+
+// RecalculateCharacterizationFactors recomputes the GHG emission properties of the CarbonFootprint
+// under the CharacterizationFactor to, using the gas breakdown of every property.
+//
+// A property with a value different from zero MUST have a gas breakdown whose CO2e,
+// under the original CharacterizationFactor, matches the reported value.
+// Otherwise ErrGasBreakdownMissing or ErrGasBreakdownMismatch is returned.
+func RecalculateCharacterizationFactors(footprint CarbonFootprint, breakdown GasBreakdown, to CharacterizationFactor) (Recalculation, error) {
+	from := footprint.CharacterizationFactors
+	if _, ok := gwp100[from]; !ok {
+		return Recalculation{}, ErrCharacterizationFactorParse
+	}
+	if _, ok := gwp100[to]; !ok {
+		return Recalculation{}, ErrCharacterizationFactorParse
+	}
+
+	recalculation := Recalculation{
+		Footprint: footprint,
+		From:      from,
+		To:        to,
+		Deltas:    map[string]decimal.Decimal{},
+	}
+
+	if from == to {
+		return recalculation, nil
+	}
+
+	for _, quantity := range recalculation.Footprint.ghgEmissionQuantities() {
+		inventory, ok := breakdown[quantity.name]
+		if !ok {
+			if quantity.value.IsZero() {
+				continue
+			}
+			return Recalculation{}, fmt.Errorf("%w: %s", ErrGasBreakdownMissing, quantity.name)
+		}
+
+		reported, err := inventory.CO2e(from)
+		if err != nil {
+			return Recalculation{}, fmt.Errorf("%s: %w", quantity.name, err)
+		}
+
+		difference := reported.Sub(*quantity.value).Abs()
+		if difference.GreaterThan(quantity.value.Abs().Mul(gasBreakdownTolerance)) {
+			return Recalculation{}, fmt.Errorf("%w: %s reported %s, breakdown %s", ErrGasBreakdownMismatch, quantity.name, quantity.value, reported)
+		}
+
+		recalculated, err := inventory.CO2e(to)
+		if err != nil {
+			return Recalculation{}, fmt.Errorf("%s: %w", quantity.name, err)
+		}
+
+		recalculation.Deltas[quantity.name] = recalculated.Sub(*quantity.value)
+		*quantity.value = recalculated
+	}
+
+	recalculation.Footprint.CharacterizationFactors = to
+
+	return recalculation, nil
+}
@@ -0,0 +1,111 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// The breakdown of 10 kg CO2, 0.5 kg fossil CH4 and 0.01 kg N2O,
+// that is 30.98 kgCO2e under AR5 and 27.63 kgCO2e under AR6
+const testGasBreakdown = `{
+	"pCfExcludingBiogenic": { "CO2": "10", "CH4 fossil": "0.5", "N2O": "0.01" },
+	"fossilGhgEmissions": { "CO2": "10", "CH4 fossil": "0.5", "N2O": "0.01" }
+}`
+
+func testBreakdown(t *testing.T) GasBreakdown {
+	breakdown, err := ParseGasBreakdown(DataModelExtension{
+		SpecVersion: ExtensionSpecVersion,
+		DataSchema:  "https://example.com/gas-breakdown.json",
+		Data:        json.RawMessage(testGasBreakdown),
+	})
+	assert.Nil(t, err)
+	return breakdown
+}
+
+func TestRecalculateCharacterizationFactors(t *testing.T) {
+
+	ar5 := CarbonFootprint{
+		CharacterizationFactors: AR5,
+		PCfExcludingBiogenic:    decimal.RequireFromString("30.98"),
+		FossilGhgEmissions:      decimal.RequireFromString("30.98"),
+	}
+
+	recalculation, err := RecalculateCharacterizationFactors(ar5, testBreakdown(t), AR6)
+	assert.Nil(t, err)
+	assert.Equal(t, AR5, recalculation.From)
+	assert.Equal(t, AR6, recalculation.To)
+	assert.Equal(t, AR6, recalculation.Footprint.CharacterizationFactors)
+	assert.True(t, recalculation.Footprint.PCfExcludingBiogenic.Equal(decimal.RequireFromString("27.63")))
+	assert.True(t, recalculation.Footprint.FossilGhgEmissions.Equal(decimal.RequireFromString("27.63")))
+	assert.Len(t, recalculation.Deltas, 2)
+	assert.True(t, recalculation.Deltas["pCfExcludingBiogenic"].Equal(decimal.RequireFromString("-3.35")))
+	assert.True(t, recalculation.Deltas["fossilGhgEmissions"].Equal(decimal.RequireFromString("-3.35")))
+
+	// the original footprint is left untouched
+	assert.Equal(t, AR5, ar5.CharacterizationFactors)
+	assert.True(t, ar5.PCfExcludingBiogenic.Equal(decimal.RequireFromString("30.98")))
+
+	back, err := RecalculateCharacterizationFactors(recalculation.Footprint, testBreakdown(t), AR5)
+	assert.Nil(t, err)
+	assert.Equal(t, AR5, back.Footprint.CharacterizationFactors)
+	assert.True(t, back.Footprint.PCfExcludingBiogenic.Equal(decimal.RequireFromString("30.98")))
+	assert.True(t, back.Deltas["pCfExcludingBiogenic"].Equal(decimal.RequireFromString("3.35")))
+
+}
+
+func TestRecalculateCharacterizationFactorsSameFactor(t *testing.T) {
+
+	footprint := CarbonFootprint{
+		CharacterizationFactors: AR6,
+		PCfExcludingBiogenic:    decimal.RequireFromString("1.5"),
+	}
+
+	recalculation, err := RecalculateCharacterizationFactors(footprint, nil, AR6)
+	assert.Nil(t, err)
+	assert.Equal(t, footprint, recalculation.Footprint)
+	assert.Empty(t, recalculation.Deltas)
+
+}
+
+func TestRecalculateCharacterizationFactorsErrors(t *testing.T) {
+
+	footprint := CarbonFootprint{
+		CharacterizationFactors: AR5,
+		PCfExcludingBiogenic:    decimal.RequireFromString("30.98"),
+		FossilGhgEmissions:      decimal.RequireFromString("30.98"),
+		PCfIncludingBiogenic:    decimalPointer("31"),
+	}
+	_, err := RecalculateCharacterizationFactors(footprint, testBreakdown(t), AR6)
+	assert.ErrorIs(t, err, ErrGasBreakdownMissing)
+	assert.ErrorContains(t, err, "pCfIncludingBiogenic")
+
+	_, err = RecalculateCharacterizationFactors(footprint, nil, AR6)
+	assert.ErrorIs(t, err, ErrGasBreakdownMissing)
+
+	footprint.PCfIncludingBiogenic = nil
+	footprint.FossilGhgEmissions = decimal.RequireFromString("25")
+	_, err = RecalculateCharacterizationFactors(footprint, testBreakdown(t), AR6)
+	assert.ErrorIs(t, err, ErrGasBreakdownMismatch)
+
+	_, err = RecalculateCharacterizationFactors(footprint, testBreakdown(t), "AR4")
+	assert.ErrorIs(t, err, ErrCharacterizationFactorParse)
+
+}
+
+func TestParseGasBreakdown(t *testing.T) {
+
+	for data, expected := range map[string]error{
+		``:                                      ErrGasBreakdownMissing,
+		`null`:                                  ErrGasBreakdownMissing,
+		`[1, 2]`:                                ErrGasBreakdownFormat,
+		`{"pCfExcludingBiogenic": "10"}`:        ErrGasBreakdownFormat,
+		`{"pCfExcludingBiogenic": {"H2": "1"}}`: ErrGasBreakdownFormat,
+	} {
+		_, err := ParseGasBreakdown(DataModelExtension{Data: json.RawMessage(data)})
+		assert.ErrorIs(t, err, expected, data)
+	}
+
+}
@@ -0,0 +1,201 @@
+package schema
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"sync"
+)
+
+// Error when no schema of the catalog has the dataSchema of an extension
+var ErrUnknownSchema = errors.New("unknown extension schema")
+
+// Error when the Data of an extension is not valid against its schema
+var ErrSchemaViolation = errors.New("extension data not valid against its schema")
+
+// Error reading a schema of the catalog
+var ErrSchemaInvalid = errors.New("invalid extension schema")
+
+// The schemas of the extensions supported by the package
+//
+//go:embed data/schemas
+var catalogFS embed.FS
+
+var defaultSchemaCatalog = mustLoadSchemaCatalog()
+
+// SchemaCatalog is a local catalog of extension JSON schemas keyed by their dataSchema URL,
+// used to validate extensions without fetching their schema.
+// It is safe for concurrent use.
+type SchemaCatalog struct {
+	schemas map[string]any
+
+	mu      sync.RWMutex
+	aliases map[string]string
+}
+
+// DefaultSchemaCatalog returns the catalog of the extension schemas embedded in the package
+func DefaultSchemaCatalog() *SchemaCatalog {
+	return defaultSchemaCatalog
+}
+
+func mustLoadSchemaCatalog() *SchemaCatalog {
+	schemas, err := fs.Sub(catalogFS, "data/schemas")
+	if err != nil {
+		panic(err.Error())
+	}
+	catalog, err := LoadSchemaCatalog(schemas)
+	if err != nil {
+		panic(err.Error())
+	}
+	if err := catalog.RegisterAlias(ShipmentDataSchemaAlias, ShipmentDataSchema); err != nil {
+		panic(err.Error())
+	}
+	return catalog
+}
+
+// LoadSchemaCatalog reads the JSON schemas of the file system, e.g. an embed.FS or os.DirFS.
+// Every schema is keyed by its $id, which must be the dataSchema URL of the extension.
+func LoadSchemaCatalog(fsys fs.FS) (*SchemaCatalog, error) {
+	catalog := &SchemaCatalog{schemas: map[string]any{}, aliases: map[string]string{}}
+
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || path.Ext(name) != ".json" {
+			return err
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+
+		document, err := decodeJSON(data)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrSchemaInvalid, name, err)
+		}
+
+		object, _ := document.(map[string]any)
+		id, _ := object["$id"].(string)
+		if id == "" {
+			return fmt.Errorf("%w: %s: missing $id", ErrSchemaInvalid, name)
+		}
+		if err := checkPatterns(object); err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrSchemaInvalid, name, err)
+		}
+
+		catalog.schemas[id] = object
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return catalog, nil
+}
+
+// checkPatterns checks the regular expressions of the schema compile
+func checkPatterns(schema any) error {
+	switch typed := schema.(type) {
+	case map[string]any:
+		if pattern, ok := typed["pattern"].(string); ok {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return err
+			}
+		}
+		for _, value := range typed {
+			if err := checkPatterns(value); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, value := range typed {
+			if err := checkPatterns(value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Schemas returns the sorted dataSchema URLs of the catalog
+func (c *SchemaCatalog) Schemas() []string {
+	schemas := make([]string, 0, len(c.schemas))
+	for id := range c.schemas {
+		schemas = append(schemas, id)
+	}
+	sort.Strings(schemas)
+	return schemas
+}
+
+// RegisterAlias validates the extensions with the dataSchema alias against the schema of the dataSchema,
+// e.g. when an extension schema is published under several URLs
+func (c *SchemaCatalog) RegisterAlias(alias, dataSchema string) error {
+	if _, ok := c.schemas[dataSchema]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownSchema, dataSchema)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.aliases[alias] = dataSchema
+	return nil
+}
+
+// schema returns the schema of the dataSchema or of the dataSchema it is an alias of
+func (c *SchemaCatalog) schema(dataSchema string) (any, bool) {
+	c.mu.RLock()
+	if canonical, ok := c.aliases[dataSchema]; ok {
+		dataSchema = canonical
+	}
+	c.mu.RUnlock()
+
+	schema, ok := c.schemas[dataSchema]
+	return schema, ok
+}
+
+// validate validates the Data of the extension against its schema, reporting the violations under the path
+func (c *SchemaCatalog) validate(e DataModelExtension, dataPath string) error {
+	schema, ok := c.schema(e.DataSchema)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownSchema, e.DataSchema)
+	}
+
+	data, err := e.Encoded()
+	if err != nil {
+		return err
+	}
+	document, err := decodeJSON(data)
+	if err != nil {
+		return &SchemaViolation{Path: dataPath, Message: err.Error()}
+	}
+
+	validator := &schemaValidator{root: schema, patterns: map[string]*regexp.Regexp{}}
+	validator.validate(schema, document, dataPath)
+	return errors.Join(validator.violations...)
+}
+
+// ValidateExtension validates the Data of the extension against the schema of its dataSchema.
+// It returns an error matching ErrUnknownSchema if the catalog does not have the schema,
+// or a *SchemaViolation for every violation, with the path of the value in the Data.
+func (c *SchemaCatalog) ValidateExtension(e DataModelExtension) error {
+	return c.validate(e, "")
+}
+
+// ValidateExtensions validates the extensions of the ProductFootprint,
+// reporting the violations with their path in the ProductFootprint, e.g. extensions[0].data.weight
+func (c *SchemaCatalog) ValidateExtensions(p ProductFootprint) error {
+	var errs []error
+	for i, extension := range p.Extensions {
+		path := fmt.Sprintf("extensions[%d]", i)
+		if err := c.validate(extension, path+".data"); err != nil {
+			if errors.Is(err, ErrUnknownSchema) {
+				err = fmt.Errorf("%s: %w", path, err)
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
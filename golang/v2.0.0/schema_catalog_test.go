@@ -0,0 +1,169 @@
+package schema
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultSchemaCatalog(t *testing.T) {
+
+	catalog := DefaultSchemaCatalog()
+	assert.Equal(t, []string{HOCDataSchema, ShipmentFootprintDataSchema, TOCDataSchema, ShipmentDataSchema}, catalog.Schemas())
+
+	// every extension type of the package has a schema
+	for key := range DefaultExtensions.types {
+		_, ok := catalog.schema(key.dataSchema)
+		assert.True(t, ok, key.dataSchema)
+	}
+
+	data, err := os.ReadFile("../../test_data/extension.json")
+	assert.Nil(t, err)
+
+	var extension DataModelExtension
+	assert.Nil(t, json.Unmarshal(data, &extension))
+	assert.Nil(t, catalog.ValidateExtension(extension))
+
+	shipment := extension.Value.(Shipment)
+	shipment.Weight = decimal.NewFromInt(-1)
+	shipment.ShipmentId = ""
+	product := ProductFootprint{Extensions: []DataModelExtension{
+		{SpecVersion: "2.0.0", DataSchema: ShipmentDataSchema, Value: shipment},
+		{SpecVersion: "2.0.0", DataSchema: "https://example.com/unknown.json", Data: json.RawMessage(`{}`)},
+	}}
+
+	err = catalog.ValidateExtensions(product)
+	assert.ErrorIs(t, err, ErrSchemaViolation)
+	assert.ErrorIs(t, err, ErrUnknownSchema)
+	assert.EqualError(t, err, "extensions[0].data.shipmentId: shorter than 1 characters\n"+
+		"extensions[0].data.weight: less than or equal to 0\n"+
+		"extensions[1]: unknown extension schema: https://example.com/unknown.json")
+
+}
+
+func TestDefaultSchemaCatalogLogistics(t *testing.T) {
+
+	catalog := DefaultSchemaCatalog()
+
+	var footprint ShipmentFootprint
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"mass": "87180",
+		"shipmentId": "1237890",
+		"tces": [{
+			"tceId": "abcdef",
+			"tocId": "truck-40t-euro5-de",
+			"shipmentId": "1237890",
+			"mass": "87180",
+			"distance": {"actual": "423"},
+			"origin": {"locationCountry": "DE", "locode": "DEHAM"},
+			"transportActivity": "36877.14",
+			"co2eWTW": "3503.33",
+			"co2eTTW": "2902.23"
+		}]
+	}`), &footprint))
+	toc := TOC{
+		TocId:                   "truck-40t-euro5-de",
+		Mode:                    RoadTransport,
+		EnergyCarriers:          []EnergyCarrier{{EnergyCarrier: DieselEnergy, EmissionFactorWTW: decimal.RequireFromString("3.2"), EmissionFactorTTW: decimal.RequireFromString("2.6"), RelativeShare: decimal.NewFromInt(1)}},
+		Co2eIntensityWTW:        decimal.RequireFromString("0.095"),
+		Co2eIntensityTTW:        decimal.RequireFromString("0.079"),
+		Co2eIntensityThroughput: "tkm",
+	}
+	hoc := HOC{
+		HocId:                   "warehouse-de",
+		HubType:                 WarehouseHub,
+		TemperatureControl:      AmbientTemperature,
+		EnergyCarriers:          []EnergyCarrier{{EnergyCarrier: ElectricEnergy, EmissionFactorWTW: decimal.RequireFromString("0.4"), EmissionFactorTTW: decimal.Zero, RelativeShare: decimal.NewFromInt(1)}},
+		Co2eIntensityWTW:        decimal.RequireFromString("1.2"),
+		Co2eIntensityTTW:        decimal.Zero,
+		Co2eIntensityThroughput: "tonnes",
+	}
+
+	footprintExtension, err := NewExtension(DefaultExtensions, footprint)
+	assert.Nil(t, err)
+	tocExtension, err := NewExtension(DefaultExtensions, toc)
+	assert.Nil(t, err)
+	hocExtension, err := NewExtension(DefaultExtensions, hoc)
+	assert.Nil(t, err)
+	for _, extension := range []DataModelExtension{footprintExtension, tocExtension, hocExtension} {
+		assert.Nil(t, catalog.ValidateExtension(extension), extension.DataSchema)
+	}
+
+	footprint.Tces[0].HocId = "warehouse-de"
+	toc.Mode = ""
+	hoc.Co2eIntensityThroughput = "tkm"
+	product := ProductFootprint{Extensions: []DataModelExtension{
+		{SpecVersion: "2.0.0", DataSchema: ShipmentFootprintDataSchema, Value: footprint},
+		{SpecVersion: "2.0.0", DataSchema: TOCDataSchema, Value: toc},
+		{SpecVersion: "2.0.0", DataSchema: HOCDataSchema, Value: hoc},
+	}}
+	err = catalog.ValidateExtensions(product)
+	assert.ErrorIs(t, err, ErrSchemaViolation)
+	assert.EqualError(t, err, "extensions[0].data.tces[0]: matches a schema it must not match\n"+
+		`extensions[1].data.mode: "" is not one of the allowed values`+"\n"+
+		`extensions[2].data.co2eIntensityThroughput: "tkm" is not one of the allowed values`)
+
+	// the shipment schema is published under two URLs
+	data, err := os.ReadFile("../../test_data/extension.json")
+	assert.Nil(t, err)
+	var extension DataModelExtension
+	assert.Nil(t, json.Unmarshal(data, &extension))
+	extension.DataSchema = ShipmentDataSchemaAlias
+	assert.Nil(t, catalog.ValidateExtension(extension))
+	assert.ErrorIs(t, catalog.RegisterAlias("https://example.com/alias.json", "https://example.com/unknown.json"), ErrUnknownSchema)
+
+}
+
+func TestLoadSchemaCatalog(t *testing.T) {
+
+	catalog, err := LoadSchemaCatalog(fstest.MapFS{
+		"example/1.0.0/data-model.json": {Data: []byte(`{
+			"$id": "https://example.com/1.0.0/data-model.json",
+			"type": "object",
+			"required": ["items", "kind"],
+			"properties": {
+				"kind": {"$ref": "#/$defs/kind"},
+				"items": {
+					"type": "array",
+					"minItems": 1,
+					"items": {
+						"type": "object",
+						"properties": {
+							"id": {"type": "string", "pattern": "^[A-Z]+$"},
+							"amount": {"type": "integer", "minimum": 1},
+							"date": {"type": "string", "format": "date"}
+						},
+						"additionalProperties": false
+					}
+				},
+				"value": {"oneOf": [{"type": "string"}, {"type": "number"}]}
+			},
+			"$defs": {"kind": {"enum": ["a", "b"]}}
+		}`)},
+	})
+	assert.Nil(t, err)
+
+	extension := DataModelExtension{DataSchema: "https://example.com/1.0.0/data-model.json"}
+
+	extension.Data = json.RawMessage(`{"kind": "a", "items": [{"id": "AB", "amount": 2.0, "date": "2024-01-31"}], "value": 1}`)
+	assert.Nil(t, catalog.ValidateExtension(extension))
+
+	extension.Data = json.RawMessage(`{"kind": "c", "items": [{"id": "ab", "amount": 1.5, "date": "31.01.2024", "other": true}], "value": true}`)
+	assert.EqualError(t, catalog.ValidateExtension(extension), `items[0].amount: expected integer, got number
+items[0].date: not a valid date
+items[0].id: does not match the pattern "^[A-Z]+$"
+items[0].other: additional property not allowed
+kind: "c" is not one of the allowed values
+value: matches 0 of the schemas instead of exactly one`)
+
+	extension.Data = json.RawMessage(`{"items": []}`)
+	assert.EqualError(t, catalog.ValidateExtension(extension), "kind: required\nitems: fewer than 1 items")
+
+	_, err = LoadSchemaCatalog(fstest.MapFS{"schema.json": {Data: []byte(`{"type": "object"}`)}})
+	assert.ErrorIs(t, err, ErrSchemaInvalid)
+
+}